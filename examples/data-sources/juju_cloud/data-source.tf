@@ -0,0 +1,3 @@
+data "juju_cloud" "this" {
+  name = "localhost"
+}
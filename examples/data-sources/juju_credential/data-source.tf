@@ -0,0 +1,4 @@
+data "juju_credential" "this" {
+  name  = "creddev"
+  cloud = "localhost"
+}
@@ -0,0 +1,13 @@
+data "juju_integration_data" "database" {
+  integration_id    = juju_integration.database.id
+  include_unit_data = true
+}
+
+output "database_endpoints" {
+  value = data.juju_integration_data.database.application_data["postgresql"]["endpoints"]
+}
+
+output "database_secret" {
+  value     = data.juju_integration_data.database.application_secret_data["postgresql"]["secret-user"]
+  sensitive = true
+}
@@ -0,0 +1,10 @@
+data "juju_jaas_audit_events" "model_changes" {
+  after  = "2026-01-01T00:00:00Z"
+  user   = "alice@canonical.com"
+  method = "AddModel"
+  limit  = 20
+}
+
+output "models_added_by_alice" {
+  value = [for e in data.juju_jaas_audit_events.model_changes.events : e.time if !e.is_response]
+}
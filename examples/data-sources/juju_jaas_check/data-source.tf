@@ -0,0 +1,12 @@
+data "juju_jaas_check" "dev_can_write" {
+  object   = "group-${juju_jaas_group.dev.uuid}#member"
+  relation = "writer"
+  target   = "model-${juju_model.staging.uuid}"
+}
+
+check "dev_can_write" {
+  assert {
+    condition     = data.juju_jaas_check.dev_can_write.allowed
+    error_message = "Members of the dev group cannot write to the staging model."
+  }
+}
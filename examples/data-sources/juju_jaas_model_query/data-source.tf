@@ -0,0 +1,8 @@
+# Find the models running a postgresql revision older than 500.
+data "juju_jaas_model_query" "outdated_postgresql" {
+  query = ".applications | to_entries[] | select(.value.charm == \"postgresql\" and .value[\"charm-rev\"] < 500) | .key"
+}
+
+output "outdated_postgresql_models" {
+  value = [for m in data.juju_jaas_model_query.outdated_postgresql.models : m.model_uuid if length(m.results) > 0]
+}
@@ -0,0 +1,3 @@
+data "juju_user" "this" {
+  name = "alice"
+}
@@ -0,0 +1,14 @@
+data "juju_model" "my_model" {
+  name = "default"
+}
+
+ephemeral "juju_secret" "database" {
+  model_uuid = data.juju_model.my_model.uuid
+  name       = "database-credentials"
+}
+
+provider "postgresql" {
+  host     = "db.example.com"
+  username = ephemeral.juju_secret.database.value["username"]
+  password = ephemeral.juju_secret.database.value["password"]
+}
@@ -0,0 +1,9 @@
+list "juju_cloud" "this" {
+  provider         = juju
+  include_resource = true
+
+  config {
+    # Optional: filter by cloud type
+    # type = "openstack"
+  }
+}
@@ -0,0 +1,9 @@
+list "juju_credential" "this" {
+  provider         = juju
+  include_resource = true
+
+  config {
+    # Optional: filter by cloud name
+    # cloud = "localhost"
+  }
+}
@@ -0,0 +1,9 @@
+list "juju_user" "this" {
+  provider         = juju
+  include_resource = true
+
+  config {
+    # Optional: filter by whether the user is disabled
+    # disabled = false
+  }
+}
@@ -0,0 +1,2 @@
+# Clouds can be imported using the cloud name
+$ terraform import juju_cloud.mycloud mycloud
@@ -21,6 +21,10 @@ resource "juju_cloud" "this" {
     file("${path.module}/ca.pem"),
   ]
 
+  config = {
+    use-floating-ip = "true"
+  }
+
   # Note, the first region is the DEFAULT region.
   regions = [
     {
@@ -28,6 +32,9 @@ resource "juju_cloud" "this" {
       endpoint          = "https://region-default.example.com"
       identity_endpoint = "https://identity-default.example.com"
       storage_endpoint  = "https://storage-default.example.com"
+      config = {
+        network = "default-net"
+      }
     },
     {
       name = "my-other-region"
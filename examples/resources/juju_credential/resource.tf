@@ -6,6 +6,7 @@ resource "juju_credential" "this" {
   }
 
   auth_type = "certificate"
+  validate  = true
 
   attributes = {
     client-cert    = "/srv/cert.crt"
@@ -13,3 +14,19 @@ resource "juju_credential" "this" {
     trust-password = "S0m3P@$$w0rd"
   }
 }
+
+resource "juju_credential" "aws" {
+  name = "awsdev"
+
+  cloud {
+    name = "aws"
+  }
+
+  # The keys are read from the AWS shared credentials file and are not
+  # stored in the Terraform state.
+  source {
+    type    = "aws"
+    path    = "~/.aws/credentials"
+    profile = "dev"
+  }
+}
@@ -0,0 +1,3 @@
+# JAAS access policies can be imported using the tag of the target,
+# adopting every existing relation to it
+$ terraform import juju_jaas_access_policy.production model-4b6bd192-13ac-489b-8a4f-d4a3e7a2c1f0
@@ -0,0 +1,17 @@
+resource "juju_jaas_access_policy" "production" {
+  target = "model-${juju_model.production.uuid}"
+  tuples = [
+    {
+      object   = "group-${juju_jaas_group.operators.uuid}#member"
+      relation = "administrator"
+    },
+    {
+      object   = "group-${juju_jaas_group.developers.uuid}#member"
+      relation = "reader"
+    },
+    {
+      object   = "user-${juju_jaas_service_account.pipeline.client_id}@serviceaccount"
+      relation = "writer"
+    },
+  ]
+}
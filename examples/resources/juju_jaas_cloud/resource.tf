@@ -0,0 +1,14 @@
+resource "juju_jaas_cloud" "openstack" {
+  controller = juju_jaas_controller.jaas.name
+
+  name       = "openstack"
+  type       = "openstack"
+  auth_types = ["userpass"]
+  endpoint   = "https://keystone.example.com:5000/v3"
+
+  regions = [
+    {
+      name = "region-one"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Service accounts can be imported using the client ID
+$ terraform import juju_jaas_service_account.pipeline 6a4c1c32-9c5e-4bd1-8a19-0a6e6dc1b1f4
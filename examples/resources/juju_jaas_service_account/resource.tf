@@ -0,0 +1,10 @@
+resource "juju_jaas_service_account" "pipeline" {
+  client_id    = "6a4c1c32-9c5e-4bd1-8a19-0a6e6dc1b1f4"
+  display_name = "Deployment pipeline"
+}
+
+resource "juju_jaas_access_model" "pipeline" {
+  model_uuid       = juju_model.production.uuid
+  access           = "writer"
+  service_accounts = [juju_jaas_service_account.pipeline.client_id]
+}
@@ -0,0 +1,3 @@
+# Service account credentials can be imported using the client ID of the
+# service account, the cloud and the credential name
+$ terraform import juju_jaas_service_account_credential.aws 6a4c1c32-9c5e-4bd1-8a19-0a6e6dc1b1f4:aws:pipeline
@@ -0,0 +1,11 @@
+resource "juju_jaas_service_account_credential" "aws" {
+  service_account = juju_jaas_service_account.pipeline.client_id
+  cloud           = "aws"
+  name            = "pipeline"
+  auth_type       = "access-key"
+
+  attributes = {
+    access-key = var.aws_access_key
+    secret-key = var.aws_secret_key
+  }
+}
@@ -0,0 +1,2 @@
+# Model access policies can be imported using the model UUID
+$ terraform import juju_model_access_policy.this 4b6bd192-13ac-489b-8a4f-d4a3e7a2c1f0
@@ -0,0 +1,6 @@
+resource "juju_model_access_policy" "this" {
+  model_uuid  = juju_model.dev.uuid
+  admin_users = [juju_user.lead.name]
+  write_users = [juju_user.dev.name, juju_user.qa.name]
+  read_users  = [juju_user.auditor.name]
+}
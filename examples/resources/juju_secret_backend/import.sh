@@ -1,2 +1,3 @@
-# Secret backends can be imported using their name.
+# Secret backends can be imported using their name. The vault or kubernetes
+# block is read from the backend, its write-only attributes must be set again.
 $ terraform import juju_secret_backend.my_backend my-backend-name
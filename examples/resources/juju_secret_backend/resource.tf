@@ -1,10 +1,50 @@
 resource "juju_secret_backend" "myvault" {
   name         = "myvault"
   backend_type = "vault"
-  config_wo = {
+
+  vault {
     endpoint = "https://vault.example.com:8200"
-    token    = "s.exampletoken"
+    ca_cert  = file("vault-ca.pem")
+    token_wo = var.vault_token
   }
   config_wo_version     = 1
   token_rotate_interval = "24h"
 }
+
+# Log in to Vault with an AppRole, the issued token is handed to Juju.
+resource "juju_secret_backend" "myvault_approle" {
+  name         = "myvault-approle"
+  backend_type = "vault"
+
+  vault {
+    endpoint     = "https://vault.example.com:8200"
+    mount_path   = "juju"
+    role_id      = var.vault_role_id
+    secret_id_wo = var.vault_secret_id
+  }
+  config_wo_version = 1
+}
+
+resource "juju_secret_backend" "mykubernetes" {
+  name         = "mykubernetes"
+  backend_type = "kubernetes"
+
+  kubernetes {
+    endpoint        = "https://10.0.0.10:6443"
+    namespace       = "juju-secrets"
+    service_account = "juju-secrets"
+    token_wo        = var.kubernetes_token
+  }
+  config_wo_version = 1
+}
+
+# Backend configuration can also be passed as a free-form write-only map.
+resource "juju_secret_backend" "myvault_config" {
+  name         = "myvault-config"
+  backend_type = "vault"
+  config_wo = {
+    endpoint = "https://vault.example.com:8200"
+    token    = "s.exampletoken"
+  }
+  config_wo_version = 1
+}
@@ -951,18 +951,9 @@ func (c applicationsClient) ActionExists(ctx context.Context, modelUUID, appName
 	}
 
 	// Get the CharmHub URL from model config.
-	modelconfigAPIClient := c.getModelConfigAPIClient(conn)
-	attrs, err := modelconfigAPIClient.ModelGet(ctx)
-	if err != nil {
-		return false, jujuerrors.Annotate(err, "failed to get model config")
-	}
-	modelConfig, err := config.New(config.UseDefaults, attrs)
+	charmhubURL, err := modelCharmHubURL(ctx, c.getModelConfigAPIClient(conn))
 	if err != nil {
-		return false, jujuerrors.Annotate(err, "failed to cast model config")
-	}
-	charmhubURL, _ := modelConfig.CharmHubURL()
-	if charmhubURL == "" {
-		charmhubURL = charmhub.ProductionURL
+		return false, err
 	}
 
 	// Parse the charm URL to extract the charm name (without revision).
@@ -986,6 +977,24 @@ func (c applicationsClient) ActionExists(ctx context.Context, modelUUID, appName
 	return result.HasAction(actionName), nil
 }
 
+// modelCharmHubURL returns the CharmHub URL configured for the model,
+// falling back to the production CharmHub URL when none is set.
+func modelCharmHubURL(ctx context.Context, client ModelConfigAPIClient) (string, error) {
+	attrs, err := client.ModelGet(ctx)
+	if err != nil {
+		return "", jujuerrors.Annotate(err, "failed to get model config")
+	}
+	modelConfig, err := config.New(config.UseDefaults, attrs)
+	if err != nil {
+		return "", jujuerrors.Annotate(err, "failed to cast model config")
+	}
+	charmhubURL, _ := modelConfig.CharmHubURL()
+	if charmhubURL == "" {
+		charmhubURL = charmhub.ProductionURL
+	}
+	return charmhubURL, nil
+}
+
 func (c applicationsClient) getApplicationStatusAndStorageDirectives4(ctx context.Context, conn api.Connection, appName string) (params.ApplicationStatus, map[string]jujustorage.Directive, error) {
 	clientAPIClient := c.getClientAPIClient(conn)
 
@@ -315,6 +315,28 @@ func (sc *sharedClient) AddOfferingController(ctx context.Context, name string,
 	return nil
 }
 
+// GetOfferingControllerUser returns the user connecting to a controller
+// specified in the offering_controllers configuration.
+func (sc *sharedClient) GetOfferingControllerUser(name string) (string, error) {
+	controllerConfig, ok := sc.offeringControllerConfigs[name]
+	if !ok {
+		return "", errors.NotFoundf("offering controller configuration for %q", name)
+	}
+	return configurationUser(controllerConfig), nil
+}
+
+// configurationUser returns the user a controller configuration connects
+// as: the service account if a client ID is set, the username otherwise.
+func configurationUser(config ControllerConfiguration) string {
+	if config.ClientID != "" && !strings.HasSuffix(config.ClientID, serviceAccountSuffix) {
+		return fmt.Sprintf("%s%s", config.ClientID, serviceAccountSuffix)
+	}
+	if config.ClientID != "" {
+		return config.ClientID
+	}
+	return config.Username
+}
+
 // IsOfferingController returns true if the given controller name is of one of the
 // added offering controllers.
 func (sc *sharedClient) IsOfferingController(name string) bool {
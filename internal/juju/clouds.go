@@ -9,16 +9,19 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strings"
 
 	jujuclock "github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/api/client/cloud"
+	"github.com/juju/juju/api/client/modelmanager"
 	k8s "github.com/juju/juju/caas/kubernetes"
 	"github.com/juju/juju/caas/kubernetes/clientconfig"
 	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
 	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v6"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -86,6 +89,14 @@ type AddCloudInput struct {
 	// The contents are Base64 encoded x.509 certs.
 	CACertificates []string
 
+	// Config contains optional cloud-wide configuration, used as
+	// defaults for the models in the cloud.
+	Config map[string]interface{}
+
+	// RegionConfig contains optional region specific configuration,
+	// keyed by region name.
+	RegionConfig jujucloud.RegionConfig
+
 	// Force indicates whether to force adding the cloud.
 	// Some cloud types might not function correctly on certain controllers.
 	Force bool
@@ -131,6 +142,14 @@ type UpdateCloudInput struct {
 	// of cloud infrastructure components
 	// The contents are Base64 encoded x.509 certs.
 	CACertificates []string
+
+	// Config contains optional cloud-wide configuration, used as
+	// defaults for the models in the cloud.
+	Config map[string]interface{}
+
+	// RegionConfig contains optional region specific configuration,
+	// keyed by region name.
+	RegionConfig jujucloud.RegionConfig
 }
 
 // ReadCloudInput is the input parameters for reading a cloud.
@@ -178,6 +197,30 @@ type ReadCloudOutput struct {
 	// of cloud infrastructure components
 	// The contents are PEM encoded CA certificates.
 	CACertificates []string
+
+	// Config contains the cloud-wide configuration.
+	Config map[string]interface{}
+
+	// RegionConfig contains the region specific configuration, keyed
+	// by region name.
+	RegionConfig jujucloud.RegionConfig
+}
+
+// GrantCloudInput is the input parameters for granting users access to
+// a cloud.
+type GrantCloudInput struct {
+	Cloud  string
+	Users  []string
+	Access string
+}
+
+// RevokeCloudInput is the input parameters for revoking users' access to
+// a cloud. Revoking `admin` leaves the users with `add-model` access,
+// revoking `add-model` removes all access.
+type RevokeCloudInput struct {
+	Cloud  string
+	Users  []string
+	Access string
 }
 
 // RemoveCloudInput is the input parameters for removing a cloud.
@@ -395,6 +438,8 @@ func (c *cloudsClient) AddCloud(ctx context.Context, input AddCloudInput) error
 		StorageEndpoint:   input.StorageEndpoint,
 		Regions:           input.Regions,
 		CACertificates:    encodeB64Certs(input.CACertificates),
+		Config:            input.Config,
+		RegionConfig:      input.RegionConfig,
 		SkipTLSVerify:     false,
 		IsControllerCloud: false,
 	}
@@ -422,6 +467,8 @@ func (c *cloudsClient) UpdateCloud(ctx context.Context, input UpdateCloudInput)
 		StorageEndpoint:   input.StorageEndpoint,
 		Regions:           input.Regions,
 		CACertificates:    encodeB64Certs(input.CACertificates),
+		Config:            input.Config,
+		RegionConfig:      input.RegionConfig,
 		SkipTLSVerify:     false,
 		IsControllerCloud: false,
 	}
@@ -476,9 +523,112 @@ func (c *cloudsClient) ReadCloud(ctx context.Context, input ReadCloudInput) (*Re
 		StorageEndpoint:  jjCloud.StorageEndpoint,
 		Regions:          jjCloud.Regions,
 		CACertificates:   decodedCACertificates,
+		Config:           jjCloud.Config,
+		RegionConfig:     jjCloud.RegionConfig,
 	}, nil
 }
 
+// GrantCloud grants users access to a cloud.
+func (c *cloudsClient) GrantCloud(ctx context.Context, input GrantCloudInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	cloudClient := c.getCloudAPIClient(conn)
+
+	for _, user := range input.Users {
+		if err := cloudClient.GrantCloud(ctx, user, input.Access, input.Cloud); err != nil {
+			return errors.Annotatef(err, "granting %q access to cloud %q for user %q", input.Access, input.Cloud, user)
+		}
+	}
+	return nil
+}
+
+// RevokeCloud revokes users' access to a cloud.
+func (c *cloudsClient) RevokeCloud(ctx context.Context, input RevokeCloudInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	cloudClient := c.getCloudAPIClient(conn)
+
+	for _, user := range input.Users {
+		if err := cloudClient.RevokeCloud(ctx, user, input.Access, input.Cloud); err != nil {
+			return errors.Annotatef(err, "revoking %q access to cloud %q for user %q", input.Access, input.Cloud, user)
+		}
+	}
+	return nil
+}
+
+// CloudUserAccess returns the access level of each user of a cloud,
+// keyed by user name.
+func (c *cloudsClient) CloudUserAccess(ctx context.Context, cloudName string) (map[string]string, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	cloudClient := c.getCloudAPIClient(conn)
+
+	infos, err := cloudClient.CloudInfo(ctx, []names.CloudTag{names.NewCloudTag(cloudName)})
+	if errors.Is(err, errors.NotFound) {
+		return nil, CloudNotFoundError
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "getting cloud info")
+	}
+	if len(infos) != 1 {
+		return nil, errors.Errorf("expected 1 cloud info result, got %d", len(infos))
+	}
+
+	access := make(map[string]string, len(infos[0].Users))
+	for user, info := range infos[0].Users {
+		access[user] = info.Access
+	}
+	return access, nil
+}
+
+// CloudRegionModels returns the names of the models hosted in each region
+// of a cloud, keyed by region name. Regions without models are omitted.
+// Listing every model requires controller superuser access, other users
+// only get the models visible to them, which is reported by returning
+// false.
+func (c *cloudsClient) CloudRegionModels(ctx context.Context, cloudName string) (map[string][]string, bool, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+	allModels := true
+	summaries, err := client.ListModelSummaries(ctx, c.GetUser(), true)
+	if params.IsCodeUnauthorized(err) {
+		allModels = false
+		summaries, err = client.ListModelSummaries(ctx, c.GetUser(), false)
+	}
+	if err != nil {
+		return nil, false, errors.Annotate(err, "listing models")
+	}
+
+	models := make(map[string][]string)
+	for _, summary := range summaries {
+		if summary.Cloud != cloudName {
+			continue
+		}
+		models[summary.CloudRegion] = append(models[summary.CloudRegion], summary.Name)
+	}
+	for _, names := range models {
+		sort.Strings(names)
+	}
+	return models, allModels, nil
+}
+
 // ListClouds returns the names of all clouds available on the controller.
 func (c *cloudsClient) ListClouds(ctx context.Context) ([]string, error) {
 	conn, err := c.GetConnection(ctx, nil)
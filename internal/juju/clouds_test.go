@@ -7,10 +7,14 @@ import (
 	"context"
 	"testing"
 
+	"github.com/juju/errors"
 	"github.com/juju/juju/api"
+	apicloud "github.com/juju/juju/api/client/cloud"
 	k8s "github.com/juju/juju/caas/kubernetes"
 	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
 	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v6"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 	"k8s.io/client-go/tools/clientcmd"
@@ -155,6 +159,128 @@ func (s *CloudSuite) TestAddCloud() {
 	s.Require().NoError(err)
 }
 
+func (s *CloudSuite) TestUpdateCloudConfig() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	cc := &cloudsClient{
+		SharedClient: s.mockSharedClient,
+		getCloudAPIClient: func(connection api.Connection) CloudAPIClient {
+			return s.mockCloudClient
+		},
+	}
+
+	s.mockSharedClient.EXPECT().GetConnection(gomock.Any(), gomock.Any()).Return(s.mockConnection, nil).AnyTimes()
+	s.mockConnection.EXPECT().Close().Return(nil).AnyTimes()
+
+	config := map[string]interface{}{"use-floating-ip": "true"}
+	regionConfig := jujucloud.RegionConfig{"region-a": {"network": "net-a"}}
+	s.mockCloudClient.EXPECT().UpdateCloud(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, cloud jujucloud.Cloud) error {
+			s.Require().Equal(config, cloud.Config)
+			s.Require().Equal(regionConfig, cloud.RegionConfig)
+			return nil
+		}).
+		Times(1)
+
+	err := cc.UpdateCloud(s.T().Context(), UpdateCloudInput{
+		Name:         "openstack",
+		Regions:      []jujucloud.Region{{Name: "region-a"}},
+		Config:       config,
+		RegionConfig: regionConfig,
+	})
+
+	s.Require().NoError(err)
+}
+
+func (s *CloudSuite) TestCloudUserAccess() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	cc := &cloudsClient{
+		SharedClient: s.mockSharedClient,
+		getCloudAPIClient: func(connection api.Connection) CloudAPIClient {
+			return s.mockCloudClient
+		},
+	}
+
+	s.mockSharedClient.EXPECT().GetConnection(gomock.Any(), gomock.Any()).Return(s.mockConnection, nil).AnyTimes()
+	s.mockConnection.EXPECT().Close().Return(nil).AnyTimes()
+
+	s.mockCloudClient.EXPECT().CloudInfo(gomock.Any(), []names.CloudTag{names.NewCloudTag("aws")}).
+		Return([]apicloud.CloudInfo{{
+			Users: map[string]apicloud.CloudUserInfo{
+				"admin": {Access: "admin"},
+				"bob":   {Access: "add-model"},
+			},
+		}}, nil).
+		Times(1)
+
+	access, err := cc.CloudUserAccess(s.T().Context(), "aws")
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{"admin": "admin", "bob": "add-model"}, access)
+}
+
+func (s *CloudSuite) TestGrantCloud() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	cc := &cloudsClient{
+		SharedClient: s.mockSharedClient,
+		getCloudAPIClient: func(connection api.Connection) CloudAPIClient {
+			return s.mockCloudClient
+		},
+	}
+
+	s.mockSharedClient.EXPECT().GetConnection(gomock.Any(), gomock.Any()).Return(s.mockConnection, nil).AnyTimes()
+	s.mockConnection.EXPECT().Close().Return(nil).AnyTimes()
+
+	s.mockCloudClient.EXPECT().GrantCloud(gomock.Any(), "alice", "add-model", "aws").Return(nil).Times(1)
+	s.mockCloudClient.EXPECT().GrantCloud(gomock.Any(), "bob", "add-model", "aws").Return(errors.New("boom")).Times(1)
+
+	err := cc.GrantCloud(s.T().Context(), GrantCloudInput{
+		Cloud:  "aws",
+		Users:  []string{"alice", "bob"},
+		Access: "add-model",
+	})
+	s.Require().ErrorContains(err, `granting "add-model" access to cloud "aws" for user "bob": boom`)
+}
+
+func (s *CloudSuite) TestCloudRegionModelsFallsBackToUserModels() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	cc := newCloudsClient(s.mockSharedClient)
+
+	s.mockSharedClient.EXPECT().GetConnection(gomock.Any(), gomock.Any()).Return(s.mockConnection, nil).Times(1)
+	s.mockSharedClient.EXPECT().GetUser().Return("alice").AnyTimes()
+	s.mockConnection.EXPECT().Close().Return(nil).AnyTimes()
+	s.mockConnection.EXPECT().BestFacadeVersion("ModelManager").Return(11).AnyTimes()
+
+	s.mockConnection.EXPECT().APICall(gomock.Any(), "ModelManager", 11, "", "ListModelSummaries",
+		params.ModelSummariesRequest{UserTag: "user-alice", All: true}, gomock.Any()).
+		Return(&params.Error{Code: params.CodeUnauthorized, Message: "permission denied"}).
+		Times(1)
+	s.mockConnection.EXPECT().APICall(gomock.Any(), "ModelManager", 11, "", "ListModelSummaries",
+		params.ModelSummariesRequest{UserTag: "user-alice", All: false}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ int, _, _ string, _, response any) error {
+			*(response.(*params.ModelSummaryResults)) = params.ModelSummaryResults{
+				Results: []params.ModelSummaryResult{
+					{Result: &params.ModelSummary{Name: "prod", CloudTag: "cloud-aws", CloudRegion: "us-east-1"}},
+					{Result: &params.ModelSummary{Name: "dev", CloudTag: "cloud-aws", CloudRegion: "us-east-1"}},
+					{Result: &params.ModelSummary{Name: "other", CloudTag: "cloud-gce", CloudRegion: "us-east-1"}},
+				},
+			}
+			return nil
+		}).
+		Times(1)
+
+	models, allModels, err := cc.CloudRegionModels(s.T().Context(), "aws")
+	s.Require().NoError(err)
+	s.Require().False(allModels)
+	s.Require().Equal(map[string][]string{"us-east-1": {"dev", "prod"}}, models)
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestKubernetesCloudSuite(t *testing.T) {
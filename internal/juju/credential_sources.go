@@ -0,0 +1,235 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Credential source types, named after the Juju cloud types they
+// provide credentials for.
+const (
+	CredentialSourceAWS       = "aws"
+	CredentialSourceGCE       = "gce"
+	CredentialSourceAzure     = "azure"
+	CredentialSourceOpenStack = "openstack"
+)
+
+// CredentialSourceTypes lists the supported credential source types.
+var CredentialSourceTypes = []string{
+	CredentialSourceAWS,
+	CredentialSourceGCE,
+	CredentialSourceAzure,
+	CredentialSourceOpenStack,
+}
+
+// CredentialSourceInput describes a local cloud SDK file to read a
+// credential from, in the same way `juju autoload-credentials` does.
+type CredentialSourceInput struct {
+	// Type is one of CredentialSourceTypes.
+	Type string
+	// Path is the path of the file, "~" is expanded to the home directory.
+	Path string
+	// Profile selects the AWS profile or the OpenStack cloud in the file.
+	// It defaults to "default" for AWS and is required for OpenStack
+	// files holding more than one cloud.
+	Profile string
+}
+
+// CredentialSourceOutput holds the Juju credential read from a source.
+type CredentialSourceOutput struct {
+	AuthType   string
+	Attributes map[string]string
+}
+
+// ReadCredentialSource parses a cloud SDK credential file into a Juju
+// credential. The file is read locally, nothing is sent to the
+// controller.
+func ReadCredentialSource(input CredentialSourceInput) (*CredentialSourceOutput, error) {
+	path := input.Path
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, errors.Annotate(err, "expanding credential source path")
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotatef(err, "reading credential source %q", input.Path)
+	}
+
+	switch input.Type {
+	case CredentialSourceAWS:
+		return parseAWSCredentials(data, input.Profile)
+	case CredentialSourceGCE:
+		return parseGCECredentials(data)
+	case CredentialSourceAzure:
+		return parseAzureCredentials(data)
+	case CredentialSourceOpenStack:
+		return parseOpenStackCredentials(data, input.Profile)
+	default:
+		return nil, errors.NotValidf("credential source type %q", input.Type)
+	}
+}
+
+// parseAWSCredentials parses an AWS shared credentials file, usually
+// ~/.aws/credentials.
+func parseAWSCredentials(data []byte, profile string) (*CredentialSourceOutput, error) {
+	if profile == "" {
+		profile = "default"
+	}
+	sections := make(map[string]map[string]string)
+	var current map[string]string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(strings.TrimPrefix(strings.Trim(line, "[]"), "profile "))
+			current = make(map[string]string)
+			sections[name] = current
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || current == nil {
+			continue
+		}
+		current[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Annotate(err, "parsing AWS credentials")
+	}
+
+	section, ok := sections[profile]
+	if !ok {
+		return nil, errors.NotFoundf("AWS profile %q", profile)
+	}
+	accessKey, secretKey := section["aws_access_key_id"], section["aws_secret_access_key"]
+	if accessKey == "" || secretKey == "" {
+		return nil, errors.NotValidf("AWS profile %q without aws_access_key_id and aws_secret_access_key", profile)
+	}
+	return &CredentialSourceOutput{
+		AuthType: "access-key",
+		Attributes: map[string]string{
+			"access-key": accessKey,
+			"secret-key": secretKey,
+		},
+	}, nil
+}
+
+// parseGCECredentials parses a GCE service account JSON key file.
+func parseGCECredentials(data []byte) (*CredentialSourceOutput, error) {
+	var key struct {
+		Type        string `json:"type"`
+		ProjectID   string `json:"project_id"`
+		ClientID    string `json:"client_id"`
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, errors.Annotate(err, "parsing GCE service account key")
+	}
+	if key.Type != "service_account" {
+		return nil, errors.NotValidf("GCE key of type %q, expected a service_account key", key.Type)
+	}
+	return &CredentialSourceOutput{
+		AuthType: "oauth2",
+		Attributes: map[string]string{
+			"project-id":   key.ProjectID,
+			"client-id":    key.ClientID,
+			"client-email": key.ClientEmail,
+			"private-key":  key.PrivateKey,
+		},
+	}, nil
+}
+
+// parseAzureCredentials parses an Azure SDK auth file, as written by
+// `az ad sp create-for-rbac --sdk-auth`.
+func parseAzureCredentials(data []byte) (*CredentialSourceOutput, error) {
+	var auth struct {
+		ClientID       string `json:"clientId"`
+		ClientSecret   string `json:"clientSecret"`
+		SubscriptionID string `json:"subscriptionId"`
+	}
+	if err := json.Unmarshal(data, &auth); err != nil {
+		return nil, errors.Annotate(err, "parsing Azure auth file")
+	}
+	if auth.ClientID == "" || auth.ClientSecret == "" || auth.SubscriptionID == "" {
+		return nil, errors.NotValidf("Azure auth file without clientId, clientSecret and subscriptionId")
+	}
+	return &CredentialSourceOutput{
+		AuthType: "service-principal-secret",
+		Attributes: map[string]string{
+			"application-id":       auth.ClientID,
+			"application-password": auth.ClientSecret,
+			"subscription-id":      auth.SubscriptionID,
+		},
+	}, nil
+}
+
+// parseOpenStackCredentials parses an OpenStack clouds.yaml file.
+func parseOpenStackCredentials(data []byte, profile string) (*CredentialSourceOutput, error) {
+	var file struct {
+		Clouds map[string]struct {
+			Auth struct {
+				Username          string `yaml:"username"`
+				Password          string `yaml:"password"`
+				ProjectName       string `yaml:"project_name"`
+				ProjectID         string `yaml:"project_id"`
+				DomainName        string `yaml:"domain_name"`
+				UserDomainName    string `yaml:"user_domain_name"`
+				ProjectDomainName string `yaml:"project_domain_name"`
+			} `yaml:"auth"`
+		} `yaml:"clouds"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, errors.Annotate(err, "parsing OpenStack clouds.yaml")
+	}
+	if profile == "" {
+		if len(file.Clouds) != 1 {
+			return nil, errors.NotValidf("OpenStack clouds.yaml with %d clouds and no profile", len(file.Clouds))
+		}
+		for name := range file.Clouds {
+			profile = name
+		}
+	}
+	cloud, ok := file.Clouds[profile]
+	if !ok {
+		return nil, errors.NotFoundf("OpenStack cloud %q", profile)
+	}
+	if cloud.Auth.Username == "" || cloud.Auth.Password == "" {
+		return nil, errors.NotValidf("OpenStack cloud %q without username and password", profile)
+	}
+
+	attributes := map[string]string{
+		"username": cloud.Auth.Username,
+		"password": cloud.Auth.Password,
+	}
+	for key, value := range map[string]string{
+		"tenant-name":         cloud.Auth.ProjectName,
+		"tenant-id":           cloud.Auth.ProjectID,
+		"domain-name":         cloud.Auth.DomainName,
+		"user-domain-name":    cloud.Auth.UserDomainName,
+		"project-domain-name": cloud.Auth.ProjectDomainName,
+	} {
+		if value != "" {
+			attributes[key] = value
+		}
+	}
+	return &CredentialSourceOutput{
+		AuthType:   "userpass",
+		Attributes: attributes,
+	}, nil
+}
@@ -6,11 +6,13 @@ package juju
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/juju/errors"
 	cloudapi "github.com/juju/juju/api/client/cloud"
 	"github.com/juju/juju/api/jujuclient"
 	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v6"
 )
 
@@ -51,6 +53,10 @@ type ReadCredentialInput struct {
 // ReadCredentialResponse contains the credential returned by a read request.
 type ReadCredentialResponse struct {
 	CloudCredential jujucloud.Credential
+	// Models holds the access of the current user to the models using
+	// the credential, keyed by model name. It is only set for controller
+	// credentials.
+	Models map[string]string
 }
 
 // UpdateCredentialInput contains the parameters for updating a credential.
@@ -63,12 +69,23 @@ type UpdateCredentialInput struct {
 	Name                 string
 }
 
+// ValidateCredentialInput contains the parameters for checking a
+// credential against the models using it.
+type ValidateCredentialInput struct {
+	Attributes map[string]string
+	AuthType   string
+	CloudName  string
+	Name       string
+}
+
 // DestroyCredentialInput contains the parameters for deleting a credential.
 type DestroyCredentialInput struct {
 	ClientCredential     bool
 	CloudName            string
 	ControllerCredential bool
 	Name                 string
+	// Force revokes the controller credential even if models use it.
+	Force bool
 }
 
 func newCredentialsClient(sc SharedClient) *credentialsClient {
@@ -246,12 +263,14 @@ func (c *credentialsClient) ReadCredential(ctx context.Context, input ReadCreden
 	}
 
 	var controllerCredentialFound jujucloud.Credential
+	models := map[string]string{}
 	if controllerCredential {
 		credentialContents, err := client.CredentialContents(ctx, cloudName, credentialName, true)
 		if err != nil {
-			return nil, err
+			return nil, typedError(err)
 		}
 
+		found := false
 		for _, content := range credentialContents {
 			if content.Error != nil {
 				continue
@@ -264,9 +283,16 @@ func (c *credentialsClient) ReadCredential(ctx context.Context, input ReadCreden
 					remoteCredential.Attributes,
 					false, //  CredentialContents does not provides this field
 				)
+				for _, model := range content.Result.Models {
+					models[model.Model] = model.Access
+				}
+				found = true
 				break
 			}
 		}
+		if !found {
+			return nil, errors.NotFoundf("controller credential %q for cloud %q", credentialName, cloudName)
+		}
 	}
 
 	if controllerCredential && clientCredential {
@@ -280,6 +306,7 @@ func (c *credentialsClient) ReadCredential(ctx context.Context, input ReadCreden
 	if controllerCredential {
 		return &ReadCredentialResponse{
 			CloudCredential: controllerCredentialFound,
+			Models:          models,
 		}, nil
 	}
 
@@ -347,6 +374,58 @@ func (c *credentialsClient) UpdateCredential(ctx context.Context, input UpdateCr
 	return nil
 }
 
+// ValidateCredential runs the controller's credential check of the given
+// content against every model using the credential, without storing it.
+// It returns an error describing each model the content is not valid for.
+func (c *credentialsClient) ValidateCredential(ctx context.Context, input ValidateCredentialInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	currentUser := getCurrentJujuUser(conn)
+
+	cloudCredTag, err := GetCloudCredentialTag(input.CloudName, currentUser, input.Name)
+	if err != nil {
+		return err
+	}
+
+	results, err := client.CheckCredentialsModels(ctx, params.TaggedCredentials{
+		Credentials: []params.TaggedCredential{{
+			Tag: cloudCredTag.String(),
+			Credential: params.CloudCredential{
+				AuthType:   input.AuthType,
+				Attributes: input.Attributes,
+			},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	if len(results) != 1 {
+		return errors.Errorf("expected 1 result checking credential %q, got %d", input.Name, len(results))
+	}
+
+	var problems []string
+	if results[0].Error != nil {
+		problems = append(problems, results[0].Error.Error())
+	}
+	for _, model := range results[0].Models {
+		for _, modelErr := range model.Errors {
+			if modelErr.Error != nil {
+				problems = append(problems, fmt.Sprintf("model %q: %s", model.ModelName, modelErr.Error.Error()))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return errors.Errorf("credential %q is not valid: %s", input.Name, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
 func getExistingClientCredentials() (map[string]jujucloud.CloudCredential, error) {
 	store := jujuclient.NewFileClientStore()
 	existingCredentials, err := store.AllCredentials()
@@ -403,8 +482,8 @@ func (c *credentialsClient) DestroyCredential(ctx context.Context, input Destroy
 	}
 
 	if input.ControllerCredential {
-		if err := client.RevokeCredential(ctx, *cloudCredTag, false); err != nil {
-			return err
+		if err := client.RevokeCredential(ctx, *cloudCredTag, input.Force); err != nil {
+			return typedError(err)
 		}
 	}
 
@@ -8,12 +8,21 @@ package juju
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/juju/errors"
+	"github.com/juju/juju/api"
 	apiapplication "github.com/juju/juju/api/client/application"
+	apicharms "github.com/juju/juju/api/client/charms"
+	apimodelconfig "github.com/juju/juju/api/client/modelconfig"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/domain/deployment/charm"
 	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/terraform-provider-juju/internal/charmhub"
 )
 
 const (
@@ -34,6 +43,23 @@ func NewIntegrationNotFoundError(modelUUID string) error {
 	return errors.WithType(errors.Errorf("no integration found for model %s", modelUUID), IntegrationNotFoundError)
 }
 
+// IntegrationEndpointsIncompatibleError is returned when no pair of
+// endpoints can be found to integrate two applications.
+var IntegrationEndpointsIncompatibleError = errors.ConstError("integration-endpoints-incompatible")
+
+// IntegrationEndpointsAmbiguousError is returned when more than one pair of
+// endpoints can be used to integrate two applications.
+var IntegrationEndpointsAmbiguousError = errors.ConstError("integration-endpoints-ambiguous")
+
+const (
+	// implicitEndpointName is the name of the endpoint that Juju adds
+	// to every charm.
+	implicitEndpointName = "juju-info"
+	// implicitEndpointInterface is the interface of the endpoint that
+	// Juju adds to every charm.
+	implicitEndpointInterface = "juju-info"
+)
+
 type integrationsClient struct {
 	SharedClient
 }
@@ -66,7 +92,9 @@ type ListIntegrationsInput struct {
 
 // ListIntegrationsOutput holds the result of listing integrations in a model
 type ListIntegrationsOutput struct {
-	Applications []Application
+	Applications    []Application
+	Suspended       bool
+	SuspendedReason string
 }
 
 // CreateIntegrationResponse contains the created integration applications.
@@ -77,6 +105,14 @@ type CreateIntegrationResponse struct {
 // ReadIntegrationResponse contains the integration applications.
 type ReadIntegrationResponse struct {
 	Applications []Application
+	// ID is the relation ID of the integration in the model.
+	ID int
+	// Suspended is true when the integration is suspended or being
+	// suspended.
+	Suspended bool
+	// SuspendedReason is the message given when the integration was
+	// suspended.
+	SuspendedReason string
 }
 
 // UpdateIntegrationResponse contains the updated integration applications.
@@ -92,6 +128,73 @@ type UpdateIntegrationInput struct {
 	ViaCIDRs     string
 }
 
+// SetIntegrationSuspendedInput contains the parameters for suspending or
+// resuming an integration.
+type SetIntegrationSuspendedInput struct {
+	ModelUUID string
+	Endpoints []string
+	Suspended bool
+	Reason    string
+}
+
+// ReadIntegrationDataInput contains the parameters for reading the
+// databags of an integration.
+type ReadIntegrationDataInput struct {
+	ModelUUID string
+	Endpoints []string
+	// IncludeUnits requests the unit databags in addition to the
+	// application databags.
+	IncludeUnits bool
+}
+
+// ReadIntegrationDataResponse contains the databags of an integration.
+type ReadIntegrationDataResponse struct {
+	// ApplicationData holds the application databags, keyed by
+	// application name.
+	ApplicationData map[string]map[string]string
+	// UnitData holds the unit databags, keyed by unit name. It is only
+	// populated when IncludeUnits is set.
+	UnitData map[string]map[string]string
+}
+
+// CharmEndpoint describes an endpoint declared by a charm or an offer.
+type CharmEndpoint struct {
+	Name      string
+	Interface string
+	Role      string
+}
+
+// ApplicationEndpointsInput contains the parameters for reading the
+// endpoints of applications in a model.
+type ApplicationEndpointsInput struct {
+	ModelUUID    string
+	Applications []string
+}
+
+// ApplicationEndpointsResponse contains the endpoints of the requested
+// applications keyed by application name. Applications which do not
+// exist in the model are omitted.
+type ApplicationEndpointsResponse struct {
+	Endpoints map[string][]CharmEndpoint
+}
+
+// IntegrationCandidate describes one side of a prospective integration.
+type IntegrationCandidate struct {
+	// Name is the name of the application.
+	Name string
+	// Endpoint is the requested endpoint name, empty to let it be inferred.
+	Endpoint string
+	// Endpoints are the endpoints available on the application.
+	Endpoints []CharmEndpoint
+}
+
+// EndpointPair is a pair of compatible endpoints, one for each side of an
+// integration, in the order the candidates were given.
+type EndpointPair struct {
+	A CharmEndpoint
+	B CharmEndpoint
+}
+
 func newIntegrationsClient(sc SharedClient) *integrationsClient {
 	return &integrationsClient{
 		SharedClient: sc,
@@ -204,11 +307,161 @@ func (c *integrationsClient) ReadIntegration(ctx context.Context, input *Integra
 		return nil, err
 	}
 
+	suspended, reason := relationSuspended(integration.Status)
 	return &ReadIntegrationResponse{
-		Applications: applications,
+		Applications:    applications,
+		ID:              integration.Id,
+		Suspended:       suspended,
+		SuspendedReason: reason,
 	}, nil
 }
 
+// SetIntegrationSuspended suspends or resumes an integration. Only
+// cross-model integrations can be suspended.
+func (c *integrationsClient) SetIntegrationSuspended(ctx context.Context, input *SetIntegrationSuspendedInput) error {
+	integration, err := c.ReadIntegration(ctx, &IntegrationInput{
+		ModelUUID: input.ModelUUID,
+		Endpoints: input.Endpoints,
+	})
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.GetConnection(ctx, &input.ModelUUID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apiapplication.NewClient(conn)
+	reason := input.Reason
+	if !input.Suspended {
+		reason = ""
+	}
+	return client.SetRelationSuspended(ctx, []int{integration.ID}, input.Suspended, reason)
+}
+
+// relationSuspended returns whether the relation status reports the
+// relation as suspended, along with the reason given for the suspension.
+func relationSuspended(st params.DetailedStatus) (bool, string) {
+	switch status.Status(st.Status) {
+	case status.Suspended, status.Suspending:
+		return true, st.Info
+	}
+	return false, ""
+}
+
+// ReadIntegrationData returns the databags of an integration. The databags
+// are read through the units of the applications in the model, so the
+// databags of remote applications and of applications without units are
+// not included.
+func (c *integrationsClient) ReadIntegrationData(ctx context.Context, input *ReadIntegrationDataInput) (*ReadIntegrationDataResponse, error) {
+	integration, err := c.ReadIntegration(ctx, &IntegrationInput{
+		ModelUUID: input.ModelUUID,
+		Endpoints: input.Endpoints,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.GetConnection(ctx, &input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.ModelStatus(ctx, input.ModelUUID, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	// The application databag is the same for every unit of an
+	// application, so a single unit per application is enough unless
+	// the unit databags are requested.
+	var units []names.UnitTag
+	for _, app := range integration.Applications {
+		if app.OfferURL != nil {
+			continue
+		}
+		appStatus, ok := status.Applications[app.Name]
+		if !ok {
+			continue
+		}
+		for _, unitName := range integrationUnits(appStatus, input.IncludeUnits) {
+			units = append(units, names.NewUnitTag(unitName))
+		}
+	}
+
+	response := &ReadIntegrationDataResponse{
+		ApplicationData: make(map[string]map[string]string),
+	}
+	if input.IncludeUnits {
+		response.UnitData = make(map[string]map[string]string)
+	}
+	if len(units) == 0 {
+		return response, nil
+	}
+
+	infos, err := apiapplication.NewClient(conn).UnitsInfo(ctx, units)
+	if err != nil {
+		return nil, err
+	}
+	for i, info := range infos {
+		if info.Error != nil {
+			return nil, errors.Annotatef(info.Error, "reading unit %q", units[i].Id())
+		}
+		appName, _ := names.UnitApplication(units[i].Id())
+		for _, rd := range info.RelationData {
+			if rd.RelationId != integration.ID {
+				continue
+			}
+			response.ApplicationData[appName] = databagStrings(rd.ApplicationData)
+			if !input.IncludeUnits {
+				continue
+			}
+			for unitName, unitData := range rd.UnitRelationData {
+				if _, ok := response.UnitData[unitName]; ok {
+					continue
+				}
+				response.UnitData[unitName] = databagStrings(unitData.UnitData)
+			}
+		}
+	}
+	return response, nil
+}
+
+// integrationUnits returns the names of the units to query for the
+// databags of an application. The leader is preferred when a single unit
+// is enough.
+func integrationUnits(appStatus params.ApplicationStatus, all bool) []string {
+	unitNames := make([]string, 0, len(appStatus.Units))
+	for name, unit := range appStatus.Units {
+		if !all && unit.Leader {
+			return []string{name}
+		}
+		unitNames = append(unitNames, name)
+	}
+	sort.Strings(unitNames)
+	if !all && len(unitNames) > 1 {
+		return unitNames[:1]
+	}
+	return unitNames
+}
+
+// databagStrings converts a databag into a map of strings. Databag values
+// are always strings, other values are formatted for safety.
+func databagStrings(data map[string]any) map[string]string {
+	result := make(map[string]string, len(data))
+	for k, v := range data {
+		if s, ok := v.(string); ok {
+			result[k] = s
+			continue
+		}
+		result[k] = fmt.Sprint(v)
+	}
+	return result
+}
+
 func (c *integrationsClient) DestroyIntegration(ctx context.Context, input *IntegrationInput) error {
 	conn, err := c.GetConnection(ctx, &input.ModelUUID)
 	if err != nil {
@@ -254,12 +507,214 @@ func (c integrationsClient) ListIntegrations(ctx context.Context, input *ListInt
 		if err != nil {
 			return nil, err
 		}
-		results = append(results, ListIntegrationsOutput{Applications: applications})
+		suspended, reason := relationSuspended(relation.Status)
+		results = append(results, ListIntegrationsOutput{
+			Applications:    applications,
+			Suspended:       suspended,
+			SuspendedReason: reason,
+		})
 	}
 
 	return results, nil
 }
 
+// ApplicationEndpoints returns the endpoints declared by the charms of the
+// given applications. Endpoints of charms from CharmHub are read from
+// CharmHub, endpoints of local charms are read from the charm metadata
+// stored on the controller and endpoints of remote applications are read
+// from the model status.
+func (c *integrationsClient) ApplicationEndpoints(ctx context.Context, input *ApplicationEndpointsInput) (*ApplicationEndpointsResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.ModelStatus(ctx, input.ModelUUID, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var charmhubURL string
+	response := &ApplicationEndpointsResponse{
+		Endpoints: make(map[string][]CharmEndpoint, len(input.Applications)),
+	}
+	for _, appName := range input.Applications {
+		if remote, ok := status.RemoteApplicationOfferers[appName]; ok {
+			endpoints := make([]CharmEndpoint, 0, len(remote.Endpoints))
+			for _, ep := range remote.Endpoints {
+				endpoints = append(endpoints, CharmEndpoint{
+					Name:      ep.Name,
+					Interface: ep.Interface,
+					Role:      string(ep.Role),
+				})
+			}
+			response.Endpoints[appName] = endpoints
+			continue
+		}
+		appStatus, ok := status.Applications[appName]
+		if !ok {
+			continue
+		}
+		if charmhubURL == "" && !charm.Local.Matches(charmSchema(appStatus.Charm)) {
+			charmhubURL, err = modelCharmHubURL(ctx, apimodelconfig.NewClient(conn))
+			if err != nil {
+				return nil, err
+			}
+		}
+		endpoints, err := c.charmEndpoints(ctx, conn, charmhubURL, appStatus)
+		if err != nil {
+			return nil, errors.Annotatef(err, "reading endpoints of application %q", appName)
+		}
+		response.Endpoints[appName] = endpoints
+	}
+	return response, nil
+}
+
+// charmEndpoints returns the endpoints of the charm deployed for the given
+// application, including the implicit juju-info endpoint.
+func (c *integrationsClient) charmEndpoints(ctx context.Context, conn api.Connection, charmhubURL string, appStatus params.ApplicationStatus) ([]CharmEndpoint, error) {
+	charmURL, err := charm.ParseURL(appStatus.Charm)
+	if err != nil {
+		return nil, errors.Annotatef(err, "parsing charm URL %q", appStatus.Charm)
+	}
+
+	var endpoints []CharmEndpoint
+	if charm.Local.Matches(charmURL.Schema) {
+		info, err := apicharms.NewClient(conn).CharmInfo(ctx, appStatus.Charm)
+		if err != nil {
+			return nil, err
+		}
+		for _, rel := range info.Meta.CombinedRelations() {
+			endpoints = append(endpoints, CharmEndpoint{
+				Name:      rel.Name,
+				Interface: rel.Interface,
+				Role:      string(rel.Role),
+			})
+		}
+	} else {
+		rev := appStatus.CharmRev
+		result, err := charmhub.New(charmhubURL, nil).Refresh(ctx, charmhub.CharmRefreshInput{
+			Name:     charmURL.Name,
+			Revision: &rev,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for name, rel := range result.Provides {
+			endpoints = append(endpoints, CharmEndpoint{Name: name, Interface: rel.Interface, Role: string(charm.RoleProvider)})
+		}
+		for name, rel := range result.Requires {
+			endpoints = append(endpoints, CharmEndpoint{Name: name, Interface: rel.Interface, Role: string(charm.RoleRequirer)})
+		}
+	}
+
+	hasImplicit := false
+	for _, ep := range endpoints {
+		if ep.Name == implicitEndpointName && ep.Role == string(charm.RoleProvider) {
+			hasImplicit = true
+			break
+		}
+	}
+	if !hasImplicit {
+		endpoints = append(endpoints, CharmEndpoint{
+			Name:      implicitEndpointName,
+			Interface: implicitEndpointInterface,
+			Role:      string(charm.RoleProvider),
+		})
+	}
+	return endpoints, nil
+}
+
+// charmSchema returns the schema of a charm URL, or an empty string if the
+// URL has no schema.
+func charmSchema(charmURL string) string {
+	schema, _, found := strings.Cut(charmURL, ":")
+	if !found {
+		return ""
+	}
+	return schema
+}
+
+// InferIntegrationEndpoints returns the pair of endpoints Juju would use to
+// integrate the two candidates. It follows the same rules as Juju: a pair is
+// valid when one side provides and the other requires the same interface,
+// and the implicit juju-info endpoint is only used when it is the only
+// option or explicitly requested.
+//
+// IntegrationEndpointsIncompatibleError is returned when no valid pair
+// exists and IntegrationEndpointsAmbiguousError when more than one does. In
+// the latter case the candidate pairs are listed in the error message.
+func InferIntegrationEndpoints(a, b IntegrationCandidate) (EndpointPair, error) {
+	var candidates []EndpointPair
+	for _, epA := range a.Endpoints {
+		if a.Endpoint != "" && epA.Name != a.Endpoint {
+			continue
+		}
+		for _, epB := range b.Endpoints {
+			if b.Endpoint != "" && epB.Name != b.Endpoint {
+				continue
+			}
+			if epA.Interface != epB.Interface || !counterpartRoles(epA.Role, epB.Role) {
+				continue
+			}
+			candidates = append(candidates, EndpointPair{A: epA, B: epB})
+		}
+	}
+
+	// Prefer explicit endpoints over the implicit juju-info endpoint.
+	if len(candidates) > 1 {
+		explicit := make([]EndpointPair, 0, len(candidates))
+		for _, pair := range candidates {
+			if pair.A.Name != implicitEndpointName && pair.B.Name != implicitEndpointName {
+				explicit = append(explicit, pair)
+			}
+		}
+		if len(explicit) > 0 {
+			candidates = explicit
+		}
+	}
+
+	switch len(candidates) {
+	case 1:
+		return candidates[0], nil
+	case 0:
+		return EndpointPair{}, errors.WithType(
+			errors.Errorf("no compatible endpoints between %s and %s", candidateString(a), candidateString(b)),
+			IntegrationEndpointsIncompatibleError,
+		)
+	}
+
+	pairs := make([]string, 0, len(candidates))
+	for _, pair := range candidates {
+		pairs = append(pairs, fmt.Sprintf("%s:%s %s:%s (%s)", a.Name, pair.A.Name, b.Name, pair.B.Name, pair.A.Interface))
+	}
+	sort.Strings(pairs)
+	return EndpointPair{}, errors.WithType(
+		errors.Errorf("ambiguous integration between %s and %s, candidates are:\n  %s",
+			candidateString(a), candidateString(b), strings.Join(pairs, "\n  ")),
+		IntegrationEndpointsAmbiguousError,
+	)
+}
+
+// counterpartRoles reports whether two endpoint roles can be integrated.
+func counterpartRoles(a, b string) bool {
+	switch charm.RelationRole(a) {
+	case charm.RoleProvider:
+		return charm.RelationRole(b) == charm.RoleRequirer
+	case charm.RoleRequirer:
+		return charm.RelationRole(b) == charm.RoleProvider
+	}
+	return false
+}
+
+func candidateString(c IntegrationCandidate) string {
+	if c.Endpoint == "" {
+		return fmt.Sprintf("%q", c.Name)
+	}
+	return fmt.Sprintf("%q", c.Name+":"+c.Endpoint)
+}
+
 // This function takes remote applications and endpoint status and combines them into a more usable format to return to the provider
 func parseApplications(remoteApplications map[string]params.RemoteApplicationStatus, src interface{}) ([]Application, error) {
 	applications := make([]Application, 0, 2)
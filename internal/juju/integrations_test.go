@@ -0,0 +1,120 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	postgresqlEndpoints = []CharmEndpoint{
+		{Name: "database", Interface: "postgresql_client", Role: "provider"},
+		{Name: "db", Interface: "pgsql", Role: "provider"},
+		{Name: "certificates", Interface: "tls-certificates", Role: "requirer"},
+		{Name: "juju-info", Interface: "juju-info", Role: "provider"},
+	}
+	appEndpoints = []CharmEndpoint{
+		{Name: "database", Interface: "postgresql_client", Role: "requirer"},
+		{Name: "juju-info", Interface: "juju-info", Role: "provider"},
+	}
+	legacyAppEndpoints = []CharmEndpoint{
+		{Name: "database", Interface: "postgresql_client", Role: "requirer"},
+		{Name: "legacy-db", Interface: "pgsql", Role: "requirer"},
+		{Name: "juju-info", Interface: "juju-info", Role: "provider"},
+	}
+	subordinateEndpoints = []CharmEndpoint{
+		{Name: "juju-info", Interface: "juju-info", Role: "requirer"},
+		{Name: "juju-info", Interface: "juju-info", Role: "provider"},
+	}
+)
+
+func TestInferIntegrationEndpointsSingleCandidate(t *testing.T) {
+	pair, err := InferIntegrationEndpoints(
+		IntegrationCandidate{Name: "postgresql", Endpoints: postgresqlEndpoints},
+		IntegrationCandidate{Name: "app", Endpoints: appEndpoints},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "database", pair.A.Name)
+	assert.Equal(t, "database", pair.B.Name)
+}
+
+func TestInferIntegrationEndpointsAmbiguous(t *testing.T) {
+	_, err := InferIntegrationEndpoints(
+		IntegrationCandidate{Name: "postgresql", Endpoints: postgresqlEndpoints},
+		IntegrationCandidate{Name: "app", Endpoints: legacyAppEndpoints},
+	)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, IntegrationEndpointsAmbiguousError))
+	assert.Contains(t, err.Error(), "postgresql:database app:database (postgresql_client)")
+	assert.Contains(t, err.Error(), "postgresql:db app:legacy-db (pgsql)")
+}
+
+func TestInferIntegrationEndpointsRequestedEndpoint(t *testing.T) {
+	pair, err := InferIntegrationEndpoints(
+		IntegrationCandidate{Name: "postgresql", Endpoints: postgresqlEndpoints},
+		IntegrationCandidate{Name: "app", Endpoint: "legacy-db", Endpoints: legacyAppEndpoints},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "db", pair.A.Name)
+	assert.Equal(t, "legacy-db", pair.B.Name)
+}
+
+func TestInferIntegrationEndpointsIncompatible(t *testing.T) {
+	_, err := InferIntegrationEndpoints(
+		IntegrationCandidate{Name: "postgresql", Endpoint: "certificates", Endpoints: postgresqlEndpoints},
+		IntegrationCandidate{Name: "app", Endpoints: appEndpoints},
+	)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, IntegrationEndpointsIncompatibleError))
+}
+
+func TestInferIntegrationEndpointsImplicitOnly(t *testing.T) {
+	pair, err := InferIntegrationEndpoints(
+		IntegrationCandidate{Name: "app", Endpoints: appEndpoints},
+		IntegrationCandidate{Name: "telegraf", Endpoints: subordinateEndpoints},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "juju-info", pair.A.Name)
+	assert.Equal(t, "provider", pair.A.Role)
+	assert.Equal(t, "juju-info", pair.B.Name)
+	assert.Equal(t, "requirer", pair.B.Role)
+}
+
+func TestIntegrationUnitsPrefersLeader(t *testing.T) {
+	appStatus := params.ApplicationStatus{
+		Units: map[string]params.UnitStatus{
+			"postgresql/0": {},
+			"postgresql/1": {Leader: true},
+			"postgresql/2": {},
+		},
+	}
+	assert.Equal(t, []string{"postgresql/1"}, integrationUnits(appStatus, false))
+	assert.Equal(t, []string{"postgresql/0", "postgresql/1", "postgresql/2"}, integrationUnits(appStatus, true))
+}
+
+func TestIntegrationUnitsWithoutLeader(t *testing.T) {
+	appStatus := params.ApplicationStatus{
+		Units: map[string]params.UnitStatus{
+			"postgresql/1": {},
+			"postgresql/0": {},
+		},
+	}
+	assert.Equal(t, []string{"postgresql/0"}, integrationUnits(appStatus, false))
+	assert.Empty(t, integrationUnits(params.ApplicationStatus{}, false))
+}
+
+func TestDatabagStrings(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"endpoints": "10.0.0.1:5432",
+		"port":      "5432",
+	}, databagStrings(map[string]any{
+		"endpoints": "10.0.0.1:5432",
+		"port":      5432,
+	}))
+}
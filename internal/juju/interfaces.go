@@ -11,6 +11,7 @@ import (
 	"github.com/juju/juju/api"
 	apiapplication "github.com/juju/juju/api/client/application"
 	apiclient "github.com/juju/juju/api/client/client"
+	apicloud "github.com/juju/juju/api/client/cloud"
 	apiresources "github.com/juju/juju/api/client/resources"
 	apisecrets "github.com/juju/juju/api/client/secrets"
 	apicommoncharm "github.com/juju/juju/api/common/charm"
@@ -55,6 +56,10 @@ type SharedClient interface {
 	// to the sharedClient.
 	AddOfferingController(ctx context.Context, name string, conf ControllerConfiguration) error
 
+	// GetOfferingControllerUser returns the user connecting to a controller
+	// specified in the offering_controllers configuration.
+	GetOfferingControllerUser(name string) (string, error)
+
 	// IsOfferingController returns true if the given controller name is of one of the
 	// added offering controllers.
 	IsOfferingController(name string) bool
@@ -156,9 +161,19 @@ type JaasAPIClient interface {
 	AddController(req *jaasparams.AddControllerRequest) (jaasparams.ControllerInfo, error)
 	ListControllers() ([]jaasparams.ControllerInfo, error)
 	RemoveController(req *jaasparams.RemoveControllerRequest) (jaasparams.ControllerInfo, error)
+	AddCloudToController(req *jaasparams.AddCloudToControllerRequest) error
+	RemoveCloudFromController(req *jaasparams.RemoveCloudFromControllerRequest) error
+	SetControllerDeprecated(req *jaasparams.SetControllerDeprecatedRequest) (jaasparams.ControllerInfo, error)
+	ListModels() ([]jaasparams.ModelControllerInfoListItem, error)
 	ListRelationshipTuples(req *jaasparams.ListRelationshipTuplesRequest) (*jaasparams.ListRelationshipTuplesResponse, error)
 	AddRelation(req *jaasparams.AddRelationRequest) error
 	RemoveRelation(req *jaasparams.RemoveRelationRequest) error
+	CheckRelation(req *jaasparams.CheckRelationRequest) (jaasparams.CheckRelationResponse, error)
+	FindAuditEvents(req *jaasparams.FindAuditEventsRequest) (jaasparams.AuditEvents, error)
+	CrossModelQuery(req *jaasparams.CrossModelQueryRequest) (*jaasparams.CrossModelQueryResponse, error)
+	AddServiceAccount(req *AddServiceAccountRequest) error
+	UpdateServiceAccountCredentials(req *UpdateServiceAccountCredentialsRequest) (params.UpdateCredentialResults, error)
+	ListServiceAccountCredentials(req *ListServiceAccountCredentialsRequest) (params.CredentialContentResults, error)
 	AddGroup(req *jaasparams.AddGroupRequest) (jaasparams.AddGroupResponse, error)
 	GetGroup(req *jaasparams.GetGroupRequest) (jaasparams.GetGroupResponse, error)
 	RenameGroup(req *jaasparams.RenameGroupRequest) error
@@ -178,6 +193,9 @@ type CloudAPIClient interface {
 	RemoveCloud(ctx context.Context, cloud string) error
 	AddCredential(ctx context.Context, cloud string, credential jujucloud.Credential) error
 	UserCredentials(ctx context.Context, user names.UserTag, cloud names.CloudTag) ([]names.CloudCredentialTag, error)
+	CloudInfo(ctx context.Context, tags []names.CloudTag) ([]apicloud.CloudInfo, error)
+	GrantCloud(ctx context.Context, user, access string, clouds ...string) error
+	RevokeCloud(ctx context.Context, user, access string, clouds ...string) error
 }
 
 // SpacesAPIClient defines the methods the Juju API client provides for spaces.
@@ -7,7 +7,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/canonical/jimm-go-sdk/v3/api"
 	"github.com/canonical/jimm-go-sdk/v3/api/params"
 	jujuapi "github.com/juju/juju/api"
 )
@@ -21,7 +20,7 @@ func newJaasClient(sc SharedClient) *jaasClient {
 	return &jaasClient{
 		SharedClient: sc,
 		getJaasApiClient: func(conn jujuapi.Connection) JaasAPIClient {
-			return api.NewClient(JaasConnShim{Connection: conn})
+			return newJaasAPIClient(conn)
 		},
 	}
 }
@@ -149,6 +148,27 @@ func (jc *jaasClient) ReadRelations(ctx context.Context, tuple *JaasTuple) ([]Ja
 	}
 }
 
+// CheckRelation reports whether the relation described by the tuple
+// holds, taking into account the relations it can be inferred from.
+func (jc *jaasClient) CheckRelation(ctx context.Context, tuple JaasTuple) (bool, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	req := &params.CheckRelationRequest{Tuple: toAPITuple(tuple)}
+	resp, err := client.CheckRelation(req)
+	if err != nil {
+		return false, err
+	}
+	if resp.Error != "" {
+		return false, errors.New(resp.Error)
+	}
+	return resp.Allowed, nil
+}
+
 // AddGroup attempts to create a new group with the provided name.
 func (jc *jaasClient) AddGroup(ctx context.Context, name string) (string, error) {
 	conn, err := jc.GetConnection(ctx, nil)
@@ -328,3 +348,42 @@ func (jc *jaasClient) RemoveController(ctx context.Context, req *params.RemoveCo
 	client := jc.getJaasApiClient(conn)
 	return client.RemoveController(req)
 }
+
+// SetControllerDeprecated sets whether a controller is deprecated. New
+// models are not placed on deprecated controllers.
+func (jc *jaasClient) SetControllerDeprecated(ctx context.Context, name string, deprecated bool) (params.ControllerInfo, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return params.ControllerInfo{}, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	return client.SetControllerDeprecated(&params.SetControllerDeprecatedRequest{
+		Name:       name,
+		Deprecated: deprecated,
+	})
+}
+
+// ListControllerModels returns the UUIDs of the models hosted on a
+// controller, among the models visible to the client.
+func (jc *jaasClient) ListControllerModels(ctx context.Context, controllerName string) ([]string, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	models, err := client.ListModels()
+	if err != nil {
+		return nil, err
+	}
+	uuids := make([]string, 0)
+	for _, model := range models {
+		if model.ControllerName == controllerName {
+			uuids = append(uuids, model.ModelUUID)
+		}
+	}
+	return uuids, nil
+}
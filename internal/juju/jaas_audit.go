@@ -0,0 +1,133 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/canonical/jimm-go-sdk/v3/api/params"
+	"github.com/juju/names/v6"
+)
+
+// auditEventsPageSize is the number of audit events requested from JAAS
+// at once.
+const auditEventsPageSize = 50
+
+// FindAuditEventsInput holds the filters of an audit log query. Zero
+// values do not filter.
+type FindAuditEventsInput struct {
+	After  time.Time
+	Before time.Time
+	User   string
+	Model  string
+	Method string
+	// Limit is the maximum number of events to return.
+	Limit int
+}
+
+// JaasAuditEvent is an entry of the JAAS audit log.
+type JaasAuditEvent struct {
+	Time           time.Time
+	ConversationID string
+	MessageID      uint64
+	FacadeName     string
+	FacadeMethod   string
+	FacadeVersion  int
+	ObjectID       string
+	User           string
+	Model          string
+	IsResponse     bool
+	// Params holds the JSON encoded parameters of a request.
+	Params string
+	// Errors holds the JSON encoded errors of a response.
+	Errors string
+}
+
+// FindAuditEvents returns the most recent audit events matching the
+// filters, newest first.
+func (jc *jaasClient) FindAuditEvents(ctx context.Context, input FindAuditEventsInput) ([]JaasAuditEvent, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	req := &params.FindAuditEventsRequest{
+		Model:    input.Model,
+		Method:   input.Method,
+		SortTime: true,
+	}
+	if !input.After.IsZero() {
+		req.After = input.After.Format(time.RFC3339)
+	}
+	if !input.Before.IsZero() {
+		req.Before = input.Before.Format(time.RFC3339)
+	}
+	if input.User != "" {
+		req.UserTag = names.NewUserTag(input.User).String()
+	}
+
+	events := make([]JaasAuditEvent, 0)
+	for len(events) < input.Limit {
+		req.Limit = min(input.Limit-len(events), auditEventsPageSize)
+		resp, err := client.FindAuditEvents(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range resp.Events {
+			converted, err := toJaasAuditEvent(event)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, converted)
+		}
+		if len(resp.Events) < req.Limit {
+			break
+		}
+		req.Offset += len(resp.Events)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+	}
+	return events, nil
+}
+
+func toJaasAuditEvent(event params.AuditEvent) (JaasAuditEvent, error) {
+	converted := JaasAuditEvent{
+		Time:           event.Time,
+		ConversationID: event.ConversationId,
+		MessageID:      event.MessageId,
+		FacadeName:     event.FacadeName,
+		FacadeMethod:   event.FacadeMethod,
+		FacadeVersion:  event.FacadeVersion,
+		ObjectID:       event.ObjectId,
+		Model:          event.Model,
+		IsResponse:     event.IsResponse,
+	}
+	if tag, err := names.ParseUserTag(event.UserTag); err == nil {
+		converted.User = tag.Id()
+	} else {
+		converted.User = event.UserTag
+	}
+	if len(event.Params) > 0 {
+		data, err := json.Marshal(event.Params)
+		if err != nil {
+			return JaasAuditEvent{}, err
+		}
+		converted.Params = string(data)
+	}
+	if len(event.Errors) > 0 {
+		data, err := json.Marshal(event.Errors)
+		if err != nil {
+			return JaasAuditEvent{}, err
+		}
+		converted.Errors = string(data)
+	}
+	return converted, nil
+}
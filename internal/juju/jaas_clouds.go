@@ -0,0 +1,138 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/canonical/jimm-go-sdk/v3/api/params"
+	jujucloud "github.com/juju/juju/cloud"
+	jujuparams "github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v6"
+)
+
+// AddCloudToControllerInput holds the cloud to add to a controller
+// registered in JAAS.
+type AddCloudToControllerInput struct {
+	AddCloudInput
+	// ControllerName is the name of the JAAS controller the cloud is
+	// added to.
+	ControllerName string
+}
+
+// AddCloudToController adds a cloud to JAAS, hosted on the given
+// controller.
+func (jc *jaasClient) AddCloudToController(ctx context.Context, input AddCloudToControllerInput) error {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+
+	// All clouds must have at least one default region - lp#1819409.
+	regions := input.Regions
+	if len(regions) == 0 {
+		regions = []jujucloud.Region{{Name: jujucloud.DefaultCloudRegion}}
+	}
+	authTypes := make([]string, len(input.AuthTypes))
+	for i, authType := range input.AuthTypes {
+		authTypes[i] = string(authType)
+	}
+	cloudRegions := make([]jujuparams.CloudRegion, len(regions))
+	for i, region := range regions {
+		cloudRegions[i] = jujuparams.CloudRegion{
+			Name:             region.Name,
+			Endpoint:         region.Endpoint,
+			IdentityEndpoint: region.IdentityEndpoint,
+			StorageEndpoint:  region.StorageEndpoint,
+		}
+	}
+
+	force := input.Force
+	return client.AddCloudToController(&params.AddCloudToControllerRequest{
+		AddCloudArgs: jujuparams.AddCloudArgs{
+			Name: input.Name,
+			Cloud: jujuparams.Cloud{
+				Type:             input.Type,
+				AuthTypes:        authTypes,
+				Endpoint:         input.Endpoint,
+				IdentityEndpoint: input.IdentityEndpoint,
+				StorageEndpoint:  input.StorageEndpoint,
+				Regions:          cloudRegions,
+				CACertificates:   encodeB64Certs(input.CACertificates),
+				Config:           input.Config,
+				RegionConfig:     regionConfigToParams(input.RegionConfig),
+			},
+			Force: &force,
+		},
+		ControllerName: input.ControllerName,
+	})
+}
+
+// RemoveCloudFromController removes a cloud from a controller registered
+// in JAAS.
+func (jc *jaasClient) RemoveCloudFromController(ctx context.Context, cloudName, controllerName string) error {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	return client.RemoveCloudFromController(&params.RemoveCloudFromControllerRequest{
+		CloudTag:       names.NewCloudTag(cloudName).String(),
+		ControllerName: controllerName,
+	})
+}
+
+// ReadCloudControllers returns the sorted names of the JAAS controllers
+// hosting a cloud.
+func (jc *jaasClient) ReadCloudControllers(ctx context.Context, cloudName string) ([]string, error) {
+	tuples, err := jc.ReadRelations(ctx, &JaasTuple{
+		Relation: "controller",
+		Target:   names.NewCloudTag(cloudName).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(tuples) == 0 {
+		return []string{}, nil
+	}
+
+	controllers, err := jc.ListControllers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	namesByUUID := make(map[string]string, len(controllers))
+	for _, controller := range controllers {
+		namesByUUID[controller.UUID] = controller.Name
+	}
+
+	controllerNames := make([]string, 0, len(tuples))
+	for _, tuple := range tuples {
+		uuid := strings.TrimPrefix(tuple.Object, names.ControllerTagKind+"-")
+		if name, ok := namesByUUID[uuid]; ok {
+			controllerNames = append(controllerNames, name)
+		}
+	}
+	sort.Strings(controllerNames)
+	return controllerNames, nil
+}
+
+// regionConfigToParams converts a cloud region config to its API
+// representation.
+func regionConfigToParams(regionConfig jujucloud.RegionConfig) map[string]map[string]interface{} {
+	if len(regionConfig) == 0 {
+		return nil
+	}
+	params := make(map[string]map[string]interface{}, len(regionConfig))
+	for region, attrs := range regionConfig {
+		params[region] = attrs
+	}
+	return params
+}
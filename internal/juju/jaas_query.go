@@ -0,0 +1,81 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/canonical/jimm-go-sdk/v3/api/params"
+)
+
+// crossModelQueryTypeJQ is the only query language supported by JAAS.
+const crossModelQueryTypeJQ = "jq"
+
+// JaasModelQueryResult holds the outcome of a cross-model query for a
+// single model.
+type JaasModelQueryResult struct {
+	ModelUUID string
+	// Results holds each output of the query, JSON encoded. A query
+	// iterating over the model status produces several outputs.
+	Results []string
+	// Errors holds the errors of the status call or of the query for
+	// this model.
+	Errors []string
+}
+
+// QueryModels runs a jq query against the status of every model the
+// user can access in JAAS. Results are sorted by model UUID; models
+// which failed are returned with their errors rather than failing the
+// whole query. JIMM does not paginate cross-model queries, the request
+// has no offset or limit and the response holds the outputs of every
+// model, so a single call returns the complete result.
+func (jc *jaasClient) QueryModels(ctx context.Context, query string) ([]JaasModelQueryResult, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	resp, err := client.CrossModelQuery(&params.CrossModelQueryRequest{
+		Type:  crossModelQueryTypeJQ,
+		Query: query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byModel := make(map[string]*JaasModelQueryResult)
+	get := func(uuid string) *JaasModelQueryResult {
+		if r, ok := byModel[uuid]; ok {
+			return r
+		}
+		r := &JaasModelQueryResult{ModelUUID: uuid, Results: []string{}, Errors: []string{}}
+		byModel[uuid] = r
+		return r
+	}
+	for uuid, outputs := range resp.Results {
+		r := get(uuid)
+		for _, output := range outputs {
+			data, err := json.Marshal(output)
+			if err != nil {
+				return nil, err
+			}
+			r.Results = append(r.Results, string(data))
+		}
+	}
+	for uuid, errs := range resp.Errors {
+		r := get(uuid)
+		r.Errors = append(r.Errors, errs...)
+	}
+
+	results := make([]JaasModelQueryResult, 0, len(byModel))
+	for _, r := range byModel {
+		results = append(results, *r)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].ModelUUID < results[j].ModelUUID })
+	return results, nil
+}
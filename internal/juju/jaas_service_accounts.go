@@ -0,0 +1,271 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canonical/jimm-go-sdk/v3/api"
+	jimmnames "github.com/canonical/jimm-go-sdk/v3/names"
+	"github.com/juju/errors"
+	jujuapi "github.com/juju/juju/api"
+	cloudapi "github.com/juju/juju/api/client/cloud"
+	"github.com/juju/juju/rpc/params"
+)
+
+// The JIMM SDK does not provide the service account calls of the JIMM
+// facade, the request types below mirror the ones JIMM expects.
+
+// AddServiceAccountRequest holds a request to add a service account.
+type AddServiceAccountRequest struct {
+	// ClientID holds the client ID of the service account.
+	ClientID string `json:"client-id"`
+}
+
+// UpdateServiceAccountCredentialsRequest holds a request to update the
+// cloud credentials of a service account.
+type UpdateServiceAccountCredentialsRequest struct {
+	params.UpdateCredentialArgs
+	// ClientID holds the client ID of the service account.
+	ClientID string `json:"client-id"`
+}
+
+// ListServiceAccountCredentialsRequest holds a request to list the
+// cloud credentials of a service account.
+type ListServiceAccountCredentialsRequest struct {
+	params.CloudCredentialArgs
+	// ClientID holds the client ID of the service account.
+	ClientID string `json:"client-id"`
+}
+
+// jaasAPIClient extends the JIMM SDK client with the calls of the JIMM
+// facade the SDK does not provide.
+type jaasAPIClient struct {
+	*api.Client
+	caller api.APICallCloser
+}
+
+func newJaasAPIClient(conn jujuapi.Connection) *jaasAPIClient {
+	caller := JaasConnShim{Connection: conn}
+	return &jaasAPIClient{
+		Client: api.NewClient(caller),
+		caller: caller,
+	}
+}
+
+// AddServiceAccount adds a service account owned by the current user.
+func (c *jaasAPIClient) AddServiceAccount(req *AddServiceAccountRequest) error {
+	return c.caller.APICall("JIMM", 4, "", "AddServiceAccount", req, nil)
+}
+
+// UpdateServiceAccountCredentials uploads cloud credentials owned by a
+// service account.
+func (c *jaasAPIClient) UpdateServiceAccountCredentials(req *UpdateServiceAccountCredentialsRequest) (params.UpdateCredentialResults, error) {
+	var resp params.UpdateCredentialResults
+	err := c.caller.APICall("JIMM", 4, "", "UpdateServiceAccountCredentials", req, &resp)
+	return resp, err
+}
+
+// ListServiceAccountCredentials returns the cloud credentials owned by
+// a service account.
+func (c *jaasAPIClient) ListServiceAccountCredentials(req *ListServiceAccountCredentialsRequest) (params.CredentialContentResults, error) {
+	var resp params.CredentialContentResults
+	err := c.caller.APICall("JIMM", 4, "", "ListServiceAccountCredentials", req, &resp)
+	return resp, err
+}
+
+// JaasServiceAccount represents a JAAS service account.
+type JaasServiceAccount struct {
+	// ClientID is the client ID of the service account, without
+	// the @serviceaccount domain.
+	ClientID string
+	// Administrators are the tags of the objects administering the
+	// service account.
+	Administrators []string
+}
+
+// ServiceAccountCredentialInput holds the details of a cloud credential
+// owned by a service account.
+type ServiceAccountCredentialInput struct {
+	ClientID   string
+	CloudName  string
+	Name       string
+	AuthType   string
+	Attributes map[string]string
+}
+
+// ReadServiceAccountCredentialResponse holds the non-secret details of a
+// cloud credential owned by a service account.
+type ReadServiceAccountCredentialResponse struct {
+	AuthType string
+	Valid    bool
+}
+
+// serviceAccountTarget returns the tag of a service account when it is
+// the target of a relation.
+func serviceAccountTarget(clientID string) (string, error) {
+	id, err := jimmnames.EnsureValidServiceAccountId(clientID)
+	if err != nil {
+		return "", err
+	}
+	return "serviceaccount-" + id, nil
+}
+
+// serviceAccountCredentialTag returns the tag of a cloud credential owned
+// by a service account.
+func serviceAccountCredentialTag(clientID, cloudName, name string) (string, error) {
+	id, err := jimmnames.EnsureValidServiceAccountId(clientID)
+	if err != nil {
+		return "", err
+	}
+	tag, err := GetCloudCredentialTag(cloudName, id, name)
+	if err != nil {
+		return "", err
+	}
+	return tag.String(), nil
+}
+
+// AddServiceAccount creates a service account administered by the
+// current user.
+func (jc *jaasClient) AddServiceAccount(ctx context.Context, clientID string) error {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	return client.AddServiceAccount(&AddServiceAccountRequest{ClientID: clientID})
+}
+
+// ReadServiceAccount returns the service account with the given client
+// ID. A service account without administrators cannot be managed anymore
+// and is reported as not found.
+func (jc *jaasClient) ReadServiceAccount(ctx context.Context, clientID string) (*JaasServiceAccount, error) {
+	target, err := serviceAccountTarget(clientID)
+	if err != nil {
+		return nil, err
+	}
+	tuples, err := jc.ReadRelations(ctx, &JaasTuple{Target: target, Relation: "administrator"})
+	if err != nil {
+		return nil, err
+	}
+	if len(tuples) == 0 {
+		return nil, errors.NotFoundf("service account %q", clientID)
+	}
+	administrators := make([]string, 0, len(tuples))
+	for _, tuple := range tuples {
+		administrators = append(administrators, tuple.Object)
+	}
+	return &JaasServiceAccount{ClientID: clientID, Administrators: administrators}, nil
+}
+
+// UpdateServiceAccountCredential creates or replaces a cloud credential
+// owned by the service account.
+func (jc *jaasClient) UpdateServiceAccountCredential(ctx context.Context, input ServiceAccountCredentialInput) error {
+	tag, err := serviceAccountCredentialTag(input.ClientID, input.CloudName, input.Name)
+	if err != nil {
+		return err
+	}
+
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	resp, err := client.UpdateServiceAccountCredentials(&UpdateServiceAccountCredentialsRequest{
+		ClientID: input.ClientID,
+		UpdateCredentialArgs: params.UpdateCredentialArgs{
+			Credentials: []params.TaggedCredential{{
+				Tag: tag,
+				Credential: params.CloudCredential{
+					AuthType:   input.AuthType,
+					Attributes: input.Attributes,
+				},
+			}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.Results) != 1 {
+		return fmt.Errorf("expected 1 result, got %d", len(resp.Results))
+	}
+	if resp.Results[0].Error != nil {
+		return resp.Results[0].Error
+	}
+	return nil
+}
+
+// ReadServiceAccountCredential returns the details of a cloud credential
+// owned by the service account. The credential attributes are secret and
+// are not returned.
+func (jc *jaasClient) ReadServiceAccountCredential(ctx context.Context, input ServiceAccountCredentialInput) (*ReadServiceAccountCredentialResponse, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	resp, err := client.ListServiceAccountCredentials(&ListServiceAccountCredentialsRequest{
+		ClientID: input.ClientID,
+		CloudCredentialArgs: params.CloudCredentialArgs{
+			Credentials: []params.CloudCredentialArg{{
+				CloudName:      input.CloudName,
+				CredentialName: input.Name,
+			}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Results) != 1 {
+		return nil, fmt.Errorf("expected 1 result, got %d", len(resp.Results))
+	}
+	result := resp.Results[0]
+	if result.Error != nil {
+		if params.IsCodeNotFound(result.Error) {
+			return nil, errors.NotFoundf("credential %q of service account %q", input.Name, input.ClientID)
+		}
+		return nil, result.Error
+	}
+	if result.Result == nil {
+		return nil, errors.NotFoundf("credential %q of service account %q", input.Name, input.ClientID)
+	}
+	content := result.Result.Content
+	return &ReadServiceAccountCredentialResponse{
+		AuthType: content.AuthType,
+		Valid:    content.Valid == nil || *content.Valid,
+	}, nil
+}
+
+// DestroyServiceAccountCredential revokes a cloud credential owned by the
+// service account.
+func (jc *jaasClient) DestroyServiceAccountCredential(ctx context.Context, input ServiceAccountCredentialInput) error {
+	id, err := jimmnames.EnsureValidServiceAccountId(input.ClientID)
+	if err != nil {
+		return err
+	}
+	tag, err := GetCloudCredentialTag(input.CloudName, id, input.Name)
+	if err != nil {
+		return err
+	}
+
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+	err = client.RevokeCredential(ctx, *tag, false)
+	if params.IsCodeNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -7,9 +7,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/canonical/jimm-go-sdk/v3/api/params"
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/juju/api"
+	jujucloud "github.com/juju/juju/cloud"
+	jujuparams "github.com/juju/juju/rpc/params"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
@@ -153,6 +157,249 @@ func (s *JaasSuite) TestReadRelationsCancelledContext() {
 	s.Assert().Equal(expectedErr, err)
 }
 
+func (s *JaasSuite) TestCheckRelation() {
+	defer s.setupMocks(s.T()).Finish()
+
+	tuple := JaasTuple{Object: "user-alice@canonical.com", Relation: "writer", Target: "model-target"}
+	req := &params.CheckRelationRequest{Tuple: toAPITuple(tuple)}
+	s.mockJaasClient.EXPECT().CheckRelation(req).Return(params.CheckRelationResponse{Allowed: true}, nil)
+
+	client := s.getJaasClient()
+	allowed, err := client.CheckRelation(s.T().Context(), tuple)
+	s.Require().NoError(err)
+	s.Assert().True(allowed)
+}
+
+func (s *JaasSuite) TestCheckRelationResponseError() {
+	defer s.setupMocks(s.T()).Finish()
+
+	tuple := JaasTuple{Object: "user-alice@canonical.com", Relation: "writer", Target: "model-target"}
+	req := &params.CheckRelationRequest{Tuple: toAPITuple(tuple)}
+	s.mockJaasClient.EXPECT().CheckRelation(req).Return(params.CheckRelationResponse{Error: "invalid relation"}, nil)
+
+	client := s.getJaasClient()
+	allowed, err := client.CheckRelation(s.T().Context(), tuple)
+	s.Require().EqualError(err, "invalid relation")
+	s.Assert().False(allowed)
+}
+
+func (s *JaasSuite) TestAddServiceAccount() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockJaasClient.EXPECT().AddServiceAccount(&AddServiceAccountRequest{ClientID: "pipeline"}).Return(nil)
+
+	client := s.getJaasClient()
+	err := client.AddServiceAccount(s.T().Context(), "pipeline")
+	s.Require().NoError(err)
+}
+
+func (s *JaasSuite) TestReadServiceAccount() {
+	defer s.setupMocks(s.T()).Finish()
+
+	req := &params.ListRelationshipTuplesRequest{Tuple: params.RelationshipTuple{
+		Relation:     "administrator",
+		TargetObject: "serviceaccount-pipeline@serviceaccount",
+	}}
+	resp := &params.ListRelationshipTuplesResponse{Tuples: []params.RelationshipTuple{{
+		Object:       "user-alice@canonical.com",
+		Relation:     "administrator",
+		TargetObject: "serviceaccount-pipeline@serviceaccount",
+	}}}
+	s.mockJaasClient.EXPECT().ListRelationshipTuples(req).Return(resp, nil)
+
+	client := s.getJaasClient()
+	serviceAccount, err := client.ReadServiceAccount(s.T().Context(), "pipeline")
+	s.Require().NoError(err)
+	s.Assert().Equal(&JaasServiceAccount{
+		ClientID:       "pipeline",
+		Administrators: []string{"user-alice@canonical.com"},
+	}, serviceAccount)
+}
+
+func (s *JaasSuite) TestReadServiceAccountNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockJaasClient.EXPECT().ListRelationshipTuples(gomock.Any()).Return(&params.ListRelationshipTuplesResponse{}, nil)
+
+	client := s.getJaasClient()
+	_, err := client.ReadServiceAccount(s.T().Context(), "pipeline")
+	s.Require().True(jujuerrors.Is(err, jujuerrors.NotFound), err)
+}
+
+func (s *JaasSuite) TestUpdateServiceAccountCredential() {
+	defer s.setupMocks(s.T()).Finish()
+
+	req := &UpdateServiceAccountCredentialsRequest{
+		ClientID: "pipeline",
+		UpdateCredentialArgs: jujuparams.UpdateCredentialArgs{
+			Credentials: []jujuparams.TaggedCredential{{
+				Tag: "cloudcred-aws_pipeline@serviceaccount_ci",
+				Credential: jujuparams.CloudCredential{
+					AuthType:   "access-key",
+					Attributes: map[string]string{"access-key": "key", "secret-key": "secret"},
+				},
+			}},
+		},
+	}
+	resp := jujuparams.UpdateCredentialResults{Results: []jujuparams.UpdateCredentialResult{{
+		CredentialTag: "cloudcred-aws_pipeline@serviceaccount_ci",
+		Error:         &jujuparams.Error{Message: "invalid credential"},
+	}}}
+	s.mockJaasClient.EXPECT().UpdateServiceAccountCredentials(req).Return(resp, nil)
+
+	client := s.getJaasClient()
+	err := client.UpdateServiceAccountCredential(s.T().Context(), ServiceAccountCredentialInput{
+		ClientID:   "pipeline",
+		CloudName:  "aws",
+		Name:       "ci",
+		AuthType:   "access-key",
+		Attributes: map[string]string{"access-key": "key", "secret-key": "secret"},
+	})
+	s.Require().ErrorContains(err, "invalid credential")
+}
+
+func (s *JaasSuite) TestReadServiceAccountCredentialNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+
+	resp := jujuparams.CredentialContentResults{Results: []jujuparams.CredentialContentResult{{
+		Error: &jujuparams.Error{Code: jujuparams.CodeNotFound, Message: "not found"},
+	}}}
+	s.mockJaasClient.EXPECT().ListServiceAccountCredentials(gomock.Any()).Return(resp, nil)
+
+	client := s.getJaasClient()
+	_, err := client.ReadServiceAccountCredential(s.T().Context(), ServiceAccountCredentialInput{
+		ClientID:  "pipeline",
+		CloudName: "aws",
+		Name:      "ci",
+	})
+	s.Require().True(jujuerrors.Is(err, jujuerrors.NotFound), err)
+}
+
+func (s *JaasSuite) TestFindAuditEvents() {
+	defer s.setupMocks(s.T()).Finish()
+
+	page := func(n int) params.AuditEvents {
+		events := make([]params.AuditEvent, n)
+		for i := range events {
+			events[i] = params.AuditEvent{
+				UserTag:      "user-alice@canonical.com",
+				FacadeMethod: "AddModel",
+				Params:       map[string]any{"name": "test"},
+			}
+		}
+		return params.AuditEvents{Events: events}
+	}
+	after := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	gomock.InOrder(
+		s.mockJaasClient.EXPECT().FindAuditEvents(&params.FindAuditEventsRequest{
+			After:    "2026-01-02T03:04:05Z",
+			UserTag:  "user-alice@canonical.com",
+			Limit:    50,
+			SortTime: true,
+		}).Return(page(50), nil),
+		s.mockJaasClient.EXPECT().FindAuditEvents(&params.FindAuditEventsRequest{
+			After:    "2026-01-02T03:04:05Z",
+			UserTag:  "user-alice@canonical.com",
+			Offset:   50,
+			Limit:    10,
+			SortTime: true,
+		}).Return(page(3), nil),
+	)
+
+	client := s.getJaasClient()
+	events, err := client.FindAuditEvents(s.T().Context(), FindAuditEventsInput{
+		After: after,
+		User:  "alice@canonical.com",
+		Limit: 60,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(events, 53)
+	s.Assert().Equal("alice@canonical.com", events[0].User)
+	s.Assert().Equal(`{"name":"test"}`, events[0].Params)
+	s.Assert().Empty(events[0].Errors)
+}
+
+func (s *JaasSuite) TestQueryModels() {
+	defer s.setupMocks(s.T()).Finish()
+
+	req := &params.CrossModelQueryRequest{Type: "jq", Query: ".applications | keys"}
+	resp := &params.CrossModelQueryResponse{
+		Results: map[string][]any{
+			"uuid-2": {[]any{"postgresql"}},
+			"uuid-1": {[]any{"mysql", "wordpress"}},
+		},
+		Errors: map[string][]string{
+			"uuid-3": {"failed to get model status"},
+		},
+	}
+	s.mockJaasClient.EXPECT().CrossModelQuery(req).Return(resp, nil)
+
+	client := s.getJaasClient()
+	results, err := client.QueryModels(s.T().Context(), ".applications | keys")
+	s.Require().NoError(err)
+	s.Assert().Equal([]JaasModelQueryResult{
+		{ModelUUID: "uuid-1", Results: []string{`["mysql","wordpress"]`}, Errors: []string{}},
+		{ModelUUID: "uuid-2", Results: []string{`["postgresql"]`}, Errors: []string{}},
+		{ModelUUID: "uuid-3", Results: []string{}, Errors: []string{"failed to get model status"}},
+	}, results)
+}
+
+func (s *JaasSuite) TestAddCloudToController() {
+	defer s.setupMocks(s.T()).Finish()
+
+	force := false
+	s.mockJaasClient.EXPECT().AddCloudToController(&params.AddCloudToControllerRequest{
+		AddCloudArgs: jujuparams.AddCloudArgs{
+			Name: "openstack",
+			Cloud: jujuparams.Cloud{
+				Type:           "openstack",
+				AuthTypes:      []string{"userpass"},
+				Endpoint:       "https://keystone.example.com:5000/v3",
+				Regions:        []jujuparams.CloudRegion{{Name: "default"}},
+				CACertificates: []string{},
+			},
+			Force: &force,
+		},
+		ControllerName: "controller-1",
+	}).Return(nil)
+
+	client := s.getJaasClient()
+	err := client.AddCloudToController(s.T().Context(), AddCloudToControllerInput{
+		AddCloudInput: AddCloudInput{
+			Name:      "openstack",
+			Type:      "openstack",
+			AuthTypes: []jujucloud.AuthType{"userpass"},
+			Endpoint:  "https://keystone.example.com:5000/v3",
+		},
+		ControllerName: "controller-1",
+	})
+	s.Require().NoError(err)
+}
+
+func (s *JaasSuite) TestReadCloudControllers() {
+	defer s.setupMocks(s.T()).Finish()
+
+	req := &params.ListRelationshipTuplesRequest{Tuple: params.RelationshipTuple{
+		Relation:     "controller",
+		TargetObject: "cloud-openstack",
+	}}
+	resp := &params.ListRelationshipTuplesResponse{Tuples: []params.RelationshipTuple{
+		{Object: "controller-uuid-2", Relation: "controller", TargetObject: "cloud-openstack"},
+		{Object: "controller-uuid-1", Relation: "controller", TargetObject: "cloud-openstack"},
+	}}
+	s.mockJaasClient.EXPECT().ListRelationshipTuples(req).Return(resp, nil)
+	s.mockJaasClient.EXPECT().ListControllers().Return([]params.ControllerInfo{
+		{Name: "controller-1", UUID: "uuid-1"},
+		{Name: "controller-2", UUID: "uuid-2"},
+		{Name: "controller-3", UUID: "uuid-3"},
+	}, nil)
+
+	client := s.getJaasClient()
+	controllers, err := client.ReadCloudControllers(s.T().Context(), "openstack")
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"controller-1", "controller-2"}, controllers)
+}
+
 func (s *JaasSuite) TestAddGroup() {
 	defer s.setupMocks(s.T()).Finish()
 
@@ -278,6 +525,35 @@ func (s *JaasSuite) TestAddControllerError() {
 	s.Assert().Equal(expectedErr, err)
 }
 
+func (s *JaasSuite) TestSetControllerDeprecated() {
+	defer s.setupMocks(s.T()).Finish()
+
+	req := &params.SetControllerDeprecatedRequest{Name: "name", Deprecated: true}
+	resp := params.ControllerInfo{Name: "name", Status: jujuparams.EntityStatus{Status: "deprecated"}}
+
+	s.mockJaasClient.EXPECT().SetControllerDeprecated(req).Return(resp, nil)
+
+	client := s.getJaasClient()
+	info, err := client.SetControllerDeprecated(s.T().Context(), "name", true)
+	s.Require().NoError(err)
+	s.Require().Equal(resp, info)
+}
+
+func (s *JaasSuite) TestListControllerModels() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockJaasClient.EXPECT().ListModels().Return([]params.ModelControllerInfoListItem{
+		{ModelUUID: "uuid-1", ControllerName: "name"},
+		{ModelUUID: "uuid-2", ControllerName: "other"},
+		{ModelUUID: "uuid-3", ControllerName: "name"},
+	}, nil)
+
+	client := s.getJaasClient()
+	uuids, err := client.ListControllerModels(s.T().Context(), "name")
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"uuid-1", "uuid-3"}, uuids)
+}
+
 func (s *JaasSuite) TestRemoveController() {
 	defer s.setupMocks(s.T()).Finish()
 
@@ -18,10 +18,11 @@ import (
 	api "github.com/juju/juju/api"
 	application "github.com/juju/juju/api/client/application"
 	client "github.com/juju/juju/api/client/client"
+	cloud "github.com/juju/juju/api/client/cloud"
 	resources "github.com/juju/juju/api/client/resources"
 	secrets "github.com/juju/juju/api/client/secrets"
 	charm "github.com/juju/juju/api/common/charm"
-	cloud "github.com/juju/juju/cloud"
+	cloud0 "github.com/juju/juju/cloud"
 	constraints "github.com/juju/juju/core/constraints"
 	model "github.com/juju/juju/core/model"
 	resource "github.com/juju/juju/core/resource"
@@ -326,6 +327,45 @@ func (c *MockSharedClientGetOfferingControllerConnCall) DoAndReturn(f func(conte
 	return c
 }
 
+// GetOfferingControllerUser mocks base method.
+func (m *MockSharedClient) GetOfferingControllerUser(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfferingControllerUser", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfferingControllerUser indicates an expected call of GetOfferingControllerUser.
+func (mr *MockSharedClientMockRecorder) GetOfferingControllerUser(name any) *MockSharedClientGetOfferingControllerUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfferingControllerUser", reflect.TypeOf((*MockSharedClient)(nil).GetOfferingControllerUser), name)
+	return &MockSharedClientGetOfferingControllerUserCall{Call: call}
+}
+
+// MockSharedClientGetOfferingControllerUserCall wrap *gomock.Call
+type MockSharedClientGetOfferingControllerUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSharedClientGetOfferingControllerUserCall) Return(arg0 string, arg1 error) *MockSharedClientGetOfferingControllerUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSharedClientGetOfferingControllerUserCall) Do(f func(string) (string, error)) *MockSharedClientGetOfferingControllerUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSharedClientGetOfferingControllerUserCall) DoAndReturn(f func(string) (string, error)) *MockSharedClientGetOfferingControllerUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUser mocks base method.
 func (m *MockSharedClient) GetUser() string {
 	m.ctrl.T.Helper()
@@ -2281,6 +2321,44 @@ func (m *MockJaasAPIClient) EXPECT() *MockJaasAPIClientMockRecorder {
 	return m.recorder
 }
 
+// AddCloudToController mocks base method.
+func (m *MockJaasAPIClient) AddCloudToController(req *params.AddCloudToControllerRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCloudToController", req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCloudToController indicates an expected call of AddCloudToController.
+func (mr *MockJaasAPIClientMockRecorder) AddCloudToController(req any) *MockJaasAPIClientAddCloudToControllerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCloudToController", reflect.TypeOf((*MockJaasAPIClient)(nil).AddCloudToController), req)
+	return &MockJaasAPIClientAddCloudToControllerCall{Call: call}
+}
+
+// MockJaasAPIClientAddCloudToControllerCall wrap *gomock.Call
+type MockJaasAPIClientAddCloudToControllerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientAddCloudToControllerCall) Return(arg0 error) *MockJaasAPIClientAddCloudToControllerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientAddCloudToControllerCall) Do(f func(*params.AddCloudToControllerRequest) error) *MockJaasAPIClientAddCloudToControllerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientAddCloudToControllerCall) DoAndReturn(f func(*params.AddCloudToControllerRequest) error) *MockJaasAPIClientAddCloudToControllerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddController mocks base method.
 func (m *MockJaasAPIClient) AddController(req *params.AddControllerRequest) (params.ControllerInfo, error) {
 	m.ctrl.T.Helper()
@@ -2475,6 +2553,161 @@ func (c *MockJaasAPIClientAddRoleCall) DoAndReturn(f func(*params.AddRoleRequest
 	return c
 }
 
+// AddServiceAccount mocks base method.
+func (m *MockJaasAPIClient) AddServiceAccount(req *AddServiceAccountRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceAccount", req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceAccount indicates an expected call of AddServiceAccount.
+func (mr *MockJaasAPIClientMockRecorder) AddServiceAccount(req any) *MockJaasAPIClientAddServiceAccountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceAccount", reflect.TypeOf((*MockJaasAPIClient)(nil).AddServiceAccount), req)
+	return &MockJaasAPIClientAddServiceAccountCall{Call: call}
+}
+
+// MockJaasAPIClientAddServiceAccountCall wrap *gomock.Call
+type MockJaasAPIClientAddServiceAccountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientAddServiceAccountCall) Return(arg0 error) *MockJaasAPIClientAddServiceAccountCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientAddServiceAccountCall) Do(f func(*AddServiceAccountRequest) error) *MockJaasAPIClientAddServiceAccountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientAddServiceAccountCall) DoAndReturn(f func(*AddServiceAccountRequest) error) *MockJaasAPIClientAddServiceAccountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CheckRelation mocks base method.
+func (m *MockJaasAPIClient) CheckRelation(req *params.CheckRelationRequest) (params.CheckRelationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckRelation", req)
+	ret0, _ := ret[0].(params.CheckRelationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckRelation indicates an expected call of CheckRelation.
+func (mr *MockJaasAPIClientMockRecorder) CheckRelation(req any) *MockJaasAPIClientCheckRelationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckRelation", reflect.TypeOf((*MockJaasAPIClient)(nil).CheckRelation), req)
+	return &MockJaasAPIClientCheckRelationCall{Call: call}
+}
+
+// MockJaasAPIClientCheckRelationCall wrap *gomock.Call
+type MockJaasAPIClientCheckRelationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientCheckRelationCall) Return(arg0 params.CheckRelationResponse, arg1 error) *MockJaasAPIClientCheckRelationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientCheckRelationCall) Do(f func(*params.CheckRelationRequest) (params.CheckRelationResponse, error)) *MockJaasAPIClientCheckRelationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientCheckRelationCall) DoAndReturn(f func(*params.CheckRelationRequest) (params.CheckRelationResponse, error)) *MockJaasAPIClientCheckRelationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CrossModelQuery mocks base method.
+func (m *MockJaasAPIClient) CrossModelQuery(req *params.CrossModelQueryRequest) (*params.CrossModelQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CrossModelQuery", req)
+	ret0, _ := ret[0].(*params.CrossModelQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CrossModelQuery indicates an expected call of CrossModelQuery.
+func (mr *MockJaasAPIClientMockRecorder) CrossModelQuery(req any) *MockJaasAPIClientCrossModelQueryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CrossModelQuery", reflect.TypeOf((*MockJaasAPIClient)(nil).CrossModelQuery), req)
+	return &MockJaasAPIClientCrossModelQueryCall{Call: call}
+}
+
+// MockJaasAPIClientCrossModelQueryCall wrap *gomock.Call
+type MockJaasAPIClientCrossModelQueryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientCrossModelQueryCall) Return(arg0 *params.CrossModelQueryResponse, arg1 error) *MockJaasAPIClientCrossModelQueryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientCrossModelQueryCall) Do(f func(*params.CrossModelQueryRequest) (*params.CrossModelQueryResponse, error)) *MockJaasAPIClientCrossModelQueryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientCrossModelQueryCall) DoAndReturn(f func(*params.CrossModelQueryRequest) (*params.CrossModelQueryResponse, error)) *MockJaasAPIClientCrossModelQueryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAuditEvents mocks base method.
+func (m *MockJaasAPIClient) FindAuditEvents(req *params.FindAuditEventsRequest) (params.AuditEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAuditEvents", req)
+	ret0, _ := ret[0].(params.AuditEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAuditEvents indicates an expected call of FindAuditEvents.
+func (mr *MockJaasAPIClientMockRecorder) FindAuditEvents(req any) *MockJaasAPIClientFindAuditEventsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAuditEvents", reflect.TypeOf((*MockJaasAPIClient)(nil).FindAuditEvents), req)
+	return &MockJaasAPIClientFindAuditEventsCall{Call: call}
+}
+
+// MockJaasAPIClientFindAuditEventsCall wrap *gomock.Call
+type MockJaasAPIClientFindAuditEventsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientFindAuditEventsCall) Return(arg0 params.AuditEvents, arg1 error) *MockJaasAPIClientFindAuditEventsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientFindAuditEventsCall) Do(f func(*params.FindAuditEventsRequest) (params.AuditEvents, error)) *MockJaasAPIClientFindAuditEventsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientFindAuditEventsCall) DoAndReturn(f func(*params.FindAuditEventsRequest) (params.AuditEvents, error)) *MockJaasAPIClientFindAuditEventsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetGroup mocks base method.
 func (m *MockJaasAPIClient) GetGroup(req *params.GetGroupRequest) (params.GetGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -2592,6 +2825,45 @@ func (c *MockJaasAPIClientListControllersCall) DoAndReturn(f func() ([]params.Co
 	return c
 }
 
+// ListModels mocks base method.
+func (m *MockJaasAPIClient) ListModels() ([]params.ModelControllerInfoListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModels")
+	ret0, _ := ret[0].([]params.ModelControllerInfoListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModels indicates an expected call of ListModels.
+func (mr *MockJaasAPIClientMockRecorder) ListModels() *MockJaasAPIClientListModelsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockJaasAPIClient)(nil).ListModels))
+	return &MockJaasAPIClientListModelsCall{Call: call}
+}
+
+// MockJaasAPIClientListModelsCall wrap *gomock.Call
+type MockJaasAPIClientListModelsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientListModelsCall) Return(arg0 []params.ModelControllerInfoListItem, arg1 error) *MockJaasAPIClientListModelsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientListModelsCall) Do(f func() ([]params.ModelControllerInfoListItem, error)) *MockJaasAPIClientListModelsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientListModelsCall) DoAndReturn(f func() ([]params.ModelControllerInfoListItem, error)) *MockJaasAPIClientListModelsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListRelationshipTuples mocks base method.
 func (m *MockJaasAPIClient) ListRelationshipTuples(req *params.ListRelationshipTuplesRequest) (*params.ListRelationshipTuplesResponse, error) {
 	m.ctrl.T.Helper()
@@ -2631,6 +2903,83 @@ func (c *MockJaasAPIClientListRelationshipTuplesCall) DoAndReturn(f func(*params
 	return c
 }
 
+// ListServiceAccountCredentials mocks base method.
+func (m *MockJaasAPIClient) ListServiceAccountCredentials(req *ListServiceAccountCredentialsRequest) (params0.CredentialContentResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceAccountCredentials", req)
+	ret0, _ := ret[0].(params0.CredentialContentResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceAccountCredentials indicates an expected call of ListServiceAccountCredentials.
+func (mr *MockJaasAPIClientMockRecorder) ListServiceAccountCredentials(req any) *MockJaasAPIClientListServiceAccountCredentialsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccountCredentials", reflect.TypeOf((*MockJaasAPIClient)(nil).ListServiceAccountCredentials), req)
+	return &MockJaasAPIClientListServiceAccountCredentialsCall{Call: call}
+}
+
+// MockJaasAPIClientListServiceAccountCredentialsCall wrap *gomock.Call
+type MockJaasAPIClientListServiceAccountCredentialsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientListServiceAccountCredentialsCall) Return(arg0 params0.CredentialContentResults, arg1 error) *MockJaasAPIClientListServiceAccountCredentialsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientListServiceAccountCredentialsCall) Do(f func(*ListServiceAccountCredentialsRequest) (params0.CredentialContentResults, error)) *MockJaasAPIClientListServiceAccountCredentialsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientListServiceAccountCredentialsCall) DoAndReturn(f func(*ListServiceAccountCredentialsRequest) (params0.CredentialContentResults, error)) *MockJaasAPIClientListServiceAccountCredentialsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveCloudFromController mocks base method.
+func (m *MockJaasAPIClient) RemoveCloudFromController(req *params.RemoveCloudFromControllerRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCloudFromController", req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCloudFromController indicates an expected call of RemoveCloudFromController.
+func (mr *MockJaasAPIClientMockRecorder) RemoveCloudFromController(req any) *MockJaasAPIClientRemoveCloudFromControllerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCloudFromController", reflect.TypeOf((*MockJaasAPIClient)(nil).RemoveCloudFromController), req)
+	return &MockJaasAPIClientRemoveCloudFromControllerCall{Call: call}
+}
+
+// MockJaasAPIClientRemoveCloudFromControllerCall wrap *gomock.Call
+type MockJaasAPIClientRemoveCloudFromControllerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientRemoveCloudFromControllerCall) Return(arg0 error) *MockJaasAPIClientRemoveCloudFromControllerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientRemoveCloudFromControllerCall) Do(f func(*params.RemoveCloudFromControllerRequest) error) *MockJaasAPIClientRemoveCloudFromControllerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientRemoveCloudFromControllerCall) DoAndReturn(f func(*params.RemoveCloudFromControllerRequest) error) *MockJaasAPIClientRemoveCloudFromControllerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveController mocks base method.
 func (m *MockJaasAPIClient) RemoveController(req *params.RemoveControllerRequest) (params.ControllerInfo, error) {
 	m.ctrl.T.Helper()
@@ -2860,6 +3209,84 @@ func (c *MockJaasAPIClientRenameRoleCall) DoAndReturn(f func(*params.RenameRoleR
 	return c
 }
 
+// SetControllerDeprecated mocks base method.
+func (m *MockJaasAPIClient) SetControllerDeprecated(req *params.SetControllerDeprecatedRequest) (params.ControllerInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetControllerDeprecated", req)
+	ret0, _ := ret[0].(params.ControllerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetControllerDeprecated indicates an expected call of SetControllerDeprecated.
+func (mr *MockJaasAPIClientMockRecorder) SetControllerDeprecated(req any) *MockJaasAPIClientSetControllerDeprecatedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetControllerDeprecated", reflect.TypeOf((*MockJaasAPIClient)(nil).SetControllerDeprecated), req)
+	return &MockJaasAPIClientSetControllerDeprecatedCall{Call: call}
+}
+
+// MockJaasAPIClientSetControllerDeprecatedCall wrap *gomock.Call
+type MockJaasAPIClientSetControllerDeprecatedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientSetControllerDeprecatedCall) Return(arg0 params.ControllerInfo, arg1 error) *MockJaasAPIClientSetControllerDeprecatedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientSetControllerDeprecatedCall) Do(f func(*params.SetControllerDeprecatedRequest) (params.ControllerInfo, error)) *MockJaasAPIClientSetControllerDeprecatedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientSetControllerDeprecatedCall) DoAndReturn(f func(*params.SetControllerDeprecatedRequest) (params.ControllerInfo, error)) *MockJaasAPIClientSetControllerDeprecatedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateServiceAccountCredentials mocks base method.
+func (m *MockJaasAPIClient) UpdateServiceAccountCredentials(req *UpdateServiceAccountCredentialsRequest) (params0.UpdateCredentialResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceAccountCredentials", req)
+	ret0, _ := ret[0].(params0.UpdateCredentialResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceAccountCredentials indicates an expected call of UpdateServiceAccountCredentials.
+func (mr *MockJaasAPIClientMockRecorder) UpdateServiceAccountCredentials(req any) *MockJaasAPIClientUpdateServiceAccountCredentialsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceAccountCredentials", reflect.TypeOf((*MockJaasAPIClient)(nil).UpdateServiceAccountCredentials), req)
+	return &MockJaasAPIClientUpdateServiceAccountCredentialsCall{Call: call}
+}
+
+// MockJaasAPIClientUpdateServiceAccountCredentialsCall wrap *gomock.Call
+type MockJaasAPIClientUpdateServiceAccountCredentialsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientUpdateServiceAccountCredentialsCall) Return(arg0 params0.UpdateCredentialResults, arg1 error) *MockJaasAPIClientUpdateServiceAccountCredentialsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientUpdateServiceAccountCredentialsCall) Do(f func(*UpdateServiceAccountCredentialsRequest) (params0.UpdateCredentialResults, error)) *MockJaasAPIClientUpdateServiceAccountCredentialsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientUpdateServiceAccountCredentialsCall) DoAndReturn(f func(*UpdateServiceAccountCredentialsRequest) (params0.UpdateCredentialResults, error)) *MockJaasAPIClientUpdateServiceAccountCredentialsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockCloudAPIClient is a mock of CloudAPIClient interface.
 type MockCloudAPIClient struct {
 	ctrl     *gomock.Controller
@@ -2885,7 +3312,7 @@ func (m *MockCloudAPIClient) EXPECT() *MockCloudAPIClientMockRecorder {
 }
 
 // AddCloud mocks base method.
-func (m *MockCloudAPIClient) AddCloud(ctx context.Context, arg1 cloud.Cloud, force bool) error {
+func (m *MockCloudAPIClient) AddCloud(ctx context.Context, arg1 cloud0.Cloud, force bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddCloud", ctx, arg1, force)
 	ret0, _ := ret[0].(error)
@@ -2911,19 +3338,19 @@ func (c *MockCloudAPIClientAddCloudCall) Return(arg0 error) *MockCloudAPIClientA
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockCloudAPIClientAddCloudCall) Do(f func(context.Context, cloud.Cloud, bool) error) *MockCloudAPIClientAddCloudCall {
+func (c *MockCloudAPIClientAddCloudCall) Do(f func(context.Context, cloud0.Cloud, bool) error) *MockCloudAPIClientAddCloudCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockCloudAPIClientAddCloudCall) DoAndReturn(f func(context.Context, cloud.Cloud, bool) error) *MockCloudAPIClientAddCloudCall {
+func (c *MockCloudAPIClientAddCloudCall) DoAndReturn(f func(context.Context, cloud0.Cloud, bool) error) *MockCloudAPIClientAddCloudCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // AddCredential mocks base method.
-func (m *MockCloudAPIClient) AddCredential(ctx context.Context, arg1 string, credential cloud.Credential) error {
+func (m *MockCloudAPIClient) AddCredential(ctx context.Context, arg1 string, credential cloud0.Credential) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddCredential", ctx, arg1, credential)
 	ret0, _ := ret[0].(error)
@@ -2949,22 +3376,22 @@ func (c *MockCloudAPIClientAddCredentialCall) Return(arg0 error) *MockCloudAPICl
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockCloudAPIClientAddCredentialCall) Do(f func(context.Context, string, cloud.Credential) error) *MockCloudAPIClientAddCredentialCall {
+func (c *MockCloudAPIClientAddCredentialCall) Do(f func(context.Context, string, cloud0.Credential) error) *MockCloudAPIClientAddCredentialCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockCloudAPIClientAddCredentialCall) DoAndReturn(f func(context.Context, string, cloud.Credential) error) *MockCloudAPIClientAddCredentialCall {
+func (c *MockCloudAPIClientAddCredentialCall) DoAndReturn(f func(context.Context, string, cloud0.Credential) error) *MockCloudAPIClientAddCredentialCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Cloud mocks base method.
-func (m *MockCloudAPIClient) Cloud(ctx context.Context, tag names.CloudTag) (cloud.Cloud, error) {
+func (m *MockCloudAPIClient) Cloud(ctx context.Context, tag names.CloudTag) (cloud0.Cloud, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Cloud", ctx, tag)
-	ret0, _ := ret[0].(cloud.Cloud)
+	ret0, _ := ret[0].(cloud0.Cloud)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -2982,28 +3409,67 @@ type MockCloudAPIClientCloudCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockCloudAPIClientCloudCall) Return(arg0 cloud.Cloud, arg1 error) *MockCloudAPIClientCloudCall {
+func (c *MockCloudAPIClientCloudCall) Return(arg0 cloud0.Cloud, arg1 error) *MockCloudAPIClientCloudCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCloudAPIClientCloudCall) Do(f func(context.Context, names.CloudTag) (cloud0.Cloud, error)) *MockCloudAPIClientCloudCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCloudAPIClientCloudCall) DoAndReturn(f func(context.Context, names.CloudTag) (cloud0.Cloud, error)) *MockCloudAPIClientCloudCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CloudInfo mocks base method.
+func (m *MockCloudAPIClient) CloudInfo(ctx context.Context, tags []names.CloudTag) ([]cloud.CloudInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudInfo", ctx, tags)
+	ret0, _ := ret[0].([]cloud.CloudInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloudInfo indicates an expected call of CloudInfo.
+func (mr *MockCloudAPIClientMockRecorder) CloudInfo(ctx, tags any) *MockCloudAPIClientCloudInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudInfo", reflect.TypeOf((*MockCloudAPIClient)(nil).CloudInfo), ctx, tags)
+	return &MockCloudAPIClientCloudInfoCall{Call: call}
+}
+
+// MockCloudAPIClientCloudInfoCall wrap *gomock.Call
+type MockCloudAPIClientCloudInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCloudAPIClientCloudInfoCall) Return(arg0 []cloud.CloudInfo, arg1 error) *MockCloudAPIClientCloudInfoCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockCloudAPIClientCloudCall) Do(f func(context.Context, names.CloudTag) (cloud.Cloud, error)) *MockCloudAPIClientCloudCall {
+func (c *MockCloudAPIClientCloudInfoCall) Do(f func(context.Context, []names.CloudTag) ([]cloud.CloudInfo, error)) *MockCloudAPIClientCloudInfoCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockCloudAPIClientCloudCall) DoAndReturn(f func(context.Context, names.CloudTag) (cloud.Cloud, error)) *MockCloudAPIClientCloudCall {
+func (c *MockCloudAPIClientCloudInfoCall) DoAndReturn(f func(context.Context, []names.CloudTag) ([]cloud.CloudInfo, error)) *MockCloudAPIClientCloudInfoCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Clouds mocks base method.
-func (m *MockCloudAPIClient) Clouds(ctx context.Context) (map[names.CloudTag]cloud.Cloud, error) {
+func (m *MockCloudAPIClient) Clouds(ctx context.Context) (map[names.CloudTag]cloud0.Cloud, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Clouds", ctx)
-	ret0, _ := ret[0].(map[names.CloudTag]cloud.Cloud)
+	ret0, _ := ret[0].(map[names.CloudTag]cloud0.Cloud)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -3021,19 +3487,62 @@ type MockCloudAPIClientCloudsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockCloudAPIClientCloudsCall) Return(arg0 map[names.CloudTag]cloud.Cloud, arg1 error) *MockCloudAPIClientCloudsCall {
+func (c *MockCloudAPIClientCloudsCall) Return(arg0 map[names.CloudTag]cloud0.Cloud, arg1 error) *MockCloudAPIClientCloudsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockCloudAPIClientCloudsCall) Do(f func(context.Context) (map[names.CloudTag]cloud.Cloud, error)) *MockCloudAPIClientCloudsCall {
+func (c *MockCloudAPIClientCloudsCall) Do(f func(context.Context) (map[names.CloudTag]cloud0.Cloud, error)) *MockCloudAPIClientCloudsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCloudAPIClientCloudsCall) DoAndReturn(f func(context.Context) (map[names.CloudTag]cloud0.Cloud, error)) *MockCloudAPIClientCloudsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GrantCloud mocks base method.
+func (m *MockCloudAPIClient) GrantCloud(ctx context.Context, user, access string, clouds ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, user, access}
+	for _, a := range clouds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GrantCloud", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantCloud indicates an expected call of GrantCloud.
+func (mr *MockCloudAPIClientMockRecorder) GrantCloud(ctx, user, access any, clouds ...any) *MockCloudAPIClientGrantCloudCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, user, access}, clouds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantCloud", reflect.TypeOf((*MockCloudAPIClient)(nil).GrantCloud), varargs...)
+	return &MockCloudAPIClientGrantCloudCall{Call: call}
+}
+
+// MockCloudAPIClientGrantCloudCall wrap *gomock.Call
+type MockCloudAPIClientGrantCloudCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCloudAPIClientGrantCloudCall) Return(arg0 error) *MockCloudAPIClientGrantCloudCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCloudAPIClientGrantCloudCall) Do(f func(context.Context, string, string, ...string) error) *MockCloudAPIClientGrantCloudCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockCloudAPIClientCloudsCall) DoAndReturn(f func(context.Context) (map[names.CloudTag]cloud.Cloud, error)) *MockCloudAPIClientCloudsCall {
+func (c *MockCloudAPIClientGrantCloudCall) DoAndReturn(f func(context.Context, string, string, ...string) error) *MockCloudAPIClientGrantCloudCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -3076,8 +3585,51 @@ func (c *MockCloudAPIClientRemoveCloudCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// RevokeCloud mocks base method.
+func (m *MockCloudAPIClient) RevokeCloud(ctx context.Context, user, access string, clouds ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, user, access}
+	for _, a := range clouds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeCloud", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCloud indicates an expected call of RevokeCloud.
+func (mr *MockCloudAPIClientMockRecorder) RevokeCloud(ctx, user, access any, clouds ...any) *MockCloudAPIClientRevokeCloudCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, user, access}, clouds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCloud", reflect.TypeOf((*MockCloudAPIClient)(nil).RevokeCloud), varargs...)
+	return &MockCloudAPIClientRevokeCloudCall{Call: call}
+}
+
+// MockCloudAPIClientRevokeCloudCall wrap *gomock.Call
+type MockCloudAPIClientRevokeCloudCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCloudAPIClientRevokeCloudCall) Return(arg0 error) *MockCloudAPIClientRevokeCloudCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCloudAPIClientRevokeCloudCall) Do(f func(context.Context, string, string, ...string) error) *MockCloudAPIClientRevokeCloudCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCloudAPIClientRevokeCloudCall) DoAndReturn(f func(context.Context, string, string, ...string) error) *MockCloudAPIClientRevokeCloudCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateCloud mocks base method.
-func (m *MockCloudAPIClient) UpdateCloud(ctx context.Context, arg1 cloud.Cloud) error {
+func (m *MockCloudAPIClient) UpdateCloud(ctx context.Context, arg1 cloud0.Cloud) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateCloud", ctx, arg1)
 	ret0, _ := ret[0].(error)
@@ -3103,13 +3655,13 @@ func (c *MockCloudAPIClientUpdateCloudCall) Return(arg0 error) *MockCloudAPIClie
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockCloudAPIClientUpdateCloudCall) Do(f func(context.Context, cloud.Cloud) error) *MockCloudAPIClientUpdateCloudCall {
+func (c *MockCloudAPIClientUpdateCloudCall) Do(f func(context.Context, cloud0.Cloud) error) *MockCloudAPIClientUpdateCloudCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockCloudAPIClientUpdateCloudCall) DoAndReturn(f func(context.Context, cloud.Cloud) error) *MockCloudAPIClientUpdateCloudCall {
+func (c *MockCloudAPIClientUpdateCloudCall) DoAndReturn(f func(context.Context, cloud0.Cloud) error) *MockCloudAPIClientUpdateCloudCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -6,6 +6,7 @@ package juju
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/juju/juju/api/client/modelconfig"
 	"github.com/juju/juju/api/client/modelmanager"
 	"github.com/juju/juju/api/client/modelupgrader"
+	"github.com/juju/juju/api/client/usermanager"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/semversion"
@@ -110,6 +112,34 @@ type DestroyAccessModelInput struct {
 	Access    string
 }
 
+// ReadModelAccessResponse contains the access levels granted on a model.
+type ReadModelAccessResponse struct {
+	// Owner is the name of the model owner, who always has admin
+	// access and is not part of Access.
+	Owner string
+	// Access maps the user names to their access level.
+	Access map[string]string
+}
+
+// SetModelAccessInput contains the parameters for moving the access
+// levels of the users of a model from Current to Desired. Users not in
+// Desired lose their access to the model.
+type SetModelAccessInput struct {
+	ModelUUID string
+	Current   map[string]string
+	Desired   map[string]string
+}
+
+// modelAccessLevels are the model access levels, from lowest to highest.
+var modelAccessLevels = []string{"read", "write", "admin"}
+
+// modelAccessChange is a single grant or revoke of model access.
+type modelAccessChange struct {
+	User   string
+	Grant  bool
+	Access string
+}
+
 func newModelsClient(sc SharedClient, isJAAS bool) *modelsClient {
 	if isJAAS {
 		return &modelsClient{
@@ -650,6 +680,113 @@ func (c *modelsClient) DestroyAccessModel(ctx context.Context, input DestroyAcce
 	return nil
 }
 
+// ReadModelAccess returns the access level of every user of a model,
+// except for the model owner.
+func (c *modelsClient) ReadModelAccess(ctx context.Context, modelUUID string) (*ReadModelAccessResponse, error) {
+	owner, _, err := c.ModelOwnerAndName(ctx, modelUUID)
+	if errors.Is(err, errors.NotFound) {
+		return nil, errors.WithType(err, ModelNotFoundError)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := usermanager.NewClient(conn)
+
+	users, err := client.ModelUserInfo(ctx, modelUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	access := make(map[string]string, len(users))
+	for _, user := range users {
+		if user.UserName == owner {
+			continue
+		}
+		access[user.UserName] = string(user.Access)
+	}
+	return &ReadModelAccessResponse{Owner: owner, Access: access}, nil
+}
+
+// SetModelAccess grants and revokes model access so that every user
+// ends up with its desired access level.
+func (c *modelsClient) SetModelAccess(ctx context.Context, input SetModelAccessInput) error {
+	changes := modelAccessChanges(input.Current, input.Desired)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+
+	for _, change := range changes {
+		if change.Grant {
+			err = client.GrantModel(ctx, change.User, change.Access, input.ModelUUID)
+		} else {
+			err = client.RevokeModel(ctx, change.User, change.Access, input.ModelUUID)
+		}
+		if err != nil {
+			return errors.Annotatef(err, "updating model access of user %q", change.User)
+		}
+	}
+	return nil
+}
+
+// modelAccessChanges returns the grants and revokes moving the users from
+// their current to their desired access level. Revoking an access level
+// leaves a user with the level below it, revoking read removes the user
+// from the model.
+func modelAccessChanges(current, desired map[string]string) []modelAccessChange {
+	users := make([]string, 0, len(current)+len(desired))
+	for user := range current {
+		users = append(users, user)
+	}
+	for user := range desired {
+		if _, ok := current[user]; !ok {
+			users = append(users, user)
+		}
+	}
+	sort.Strings(users)
+
+	var changes []modelAccessChange
+	for _, user := range users {
+		have, want := current[user], desired[user]
+		haveLevel, wantLevel := modelAccessLevel(have), modelAccessLevel(want)
+		switch {
+		case haveLevel == wantLevel:
+		case want == "":
+			changes = append(changes, modelAccessChange{User: user, Access: modelAccessLevels[0]})
+		case wantLevel > haveLevel:
+			changes = append(changes, modelAccessChange{User: user, Grant: true, Access: want})
+		default:
+			changes = append(changes, modelAccessChange{User: user, Access: modelAccessLevels[wantLevel+1]})
+		}
+	}
+	return changes
+}
+
+// modelAccessLevel returns the index of the access in modelAccessLevels,
+// or -1 for no access.
+func modelAccessLevel(access string) int {
+	for i, level := range modelAccessLevels {
+		if level == access {
+			return i
+		}
+	}
+	return -1
+}
+
 // SetModelDefaults sets the default model configuration for a cloud and region.
 func (c *modelsClient) SetModelDefaults(ctx context.Context, cloud string, region string, config map[string]interface{}) error {
 	conn, err := c.GetConnection(ctx, nil)
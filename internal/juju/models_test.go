@@ -0,0 +1,42 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelAccessChanges(t *testing.T) {
+	current := map[string]string{
+		"alice": "admin",
+		"bob":   "write",
+		"carol": "read",
+		"dave":  "admin",
+		"erin":  "read",
+	}
+	desired := map[string]string{
+		"alice": "read",
+		"bob":   "admin",
+		"carol": "read",
+		"dave":  "write",
+		"frank": "write",
+		"grace": "read",
+	}
+	assert.Equal(t, []modelAccessChange{
+		{User: "alice", Access: "write"},
+		{User: "bob", Grant: true, Access: "admin"},
+		{User: "dave", Access: "admin"},
+		{User: "erin", Access: "read"},
+		{User: "frank", Grant: true, Access: "write"},
+		{User: "grace", Grant: true, Access: "read"},
+	}, modelAccessChanges(current, desired))
+}
+
+func TestModelAccessChangesNone(t *testing.T) {
+	access := map[string]string{"alice": "admin"}
+	assert.Empty(t, modelAccessChanges(access, access))
+	assert.Empty(t, modelAccessChanges(nil, nil))
+}
@@ -38,6 +38,10 @@ var RemoteAppNotFoundError = errors.ConstError("remote-app-not-found")
 // while the offer still has active connections.
 var OfferHasConnectionsError = errors.ConstError("offer-has-connections")
 
+// OfferUpdateNotSupportedError is returned when an offer is updated on a
+// controller which does not support updating offers in place.
+var OfferUpdateNotSupportedError = errors.ConstError("offer-update-not-supported")
+
 // offerHasConnectionsJuju3Re matches error messages returned by Juju 3
 // when attempting to destroy an offer with active connections.
 var offerHasConnectionsJuju3Re = regexp.MustCompile(`cannot delete application offer .+: offer has \d+ relations?`)
@@ -57,6 +61,18 @@ type CreateOfferInput struct {
 	ModelUUID       string
 	OfferOwner      string
 	Name            string
+	Description     string
+}
+
+// UpdateOfferInput represents input for updating an offer in place.
+type UpdateOfferInput struct {
+	ApplicationName string
+	// Endpoints is the full set of endpoints to offer.
+	Endpoints   []string
+	ModelUUID   string
+	OfferOwner  string
+	Name        string
+	Description string
 }
 
 // CreateOfferResponse represents the response from creating an offer.
@@ -80,10 +96,41 @@ type ReadOfferInput struct {
 type ReadOfferResponse struct {
 	ApplicationName string
 	Endpoints       []string
+	// EndpointDetails holds the interface and role of each offered endpoint.
+	EndpointDetails []CharmEndpoint
 	ModelUUID       string
 	Name            string
 	OfferURL        string
+	Description     string
 	Users           []crossmodel.OfferUserDetails
+	// Connections are the integrations made with the offer. They are
+	// only visible to users with admin access to the offer.
+	Connections []OfferConnection
+}
+
+// OfferConnection describes an integration made with an offer.
+type OfferConnection struct {
+	// SourceModelUUID is the UUID of the consuming model.
+	SourceModelUUID string
+	// Username is the name of the user consuming the offer.
+	Username string
+	// RelationID is the ID of the integration in the offering model.
+	RelationID int
+	// Endpoint is the offered endpoint being integrated.
+	Endpoint string
+	// Status is the status of the integration.
+	Status string
+	// Message is the status message of the integration.
+	Message string
+	// IngressSubnets are the subnets from which the consumer connects.
+	IngressSubnets []string
+}
+
+// Suspended reports whether the integration is suspended or being
+// suspended.
+func (c OfferConnection) Suspended() bool {
+	suspended, _ := relationSuspended(params.DetailedStatus{Status: c.Status})
+	return suspended
 }
 
 // DestroyOfferInput represents input for destroying an offer.
@@ -126,6 +173,9 @@ type GrantRevokeOfferInput struct {
 	Users    []string
 	Access   string
 	OfferURL string
+	// OfferingController is the name of the offering controller hosting
+	// the offer. If empty, the offer is hosted on the provider's controller.
+	OfferingController string
 }
 
 // ListOffersInput represents input for listing offers.
@@ -143,6 +193,10 @@ type ListOffersOutput struct {
 	ModelUUID       string
 	Endpoints       []string
 	OfferURL        string
+	Description     string
+	// Connections are the integrations made with the offer. They are
+	// only visible to users with admin access to the offer.
+	Connections []OfferConnection
 }
 
 func newOffersClient(sc SharedClient) *offersClient {
@@ -182,7 +236,7 @@ func (c *offersClient) CreateOffer(ctx context.Context, input *CreateOfferInput)
 		return nil, append(errs, errors.New("the application was not available to be offered"))
 	}
 
-	result, err := client.Offer(ctx, input.ModelUUID, input.ApplicationName, input.Endpoints, input.OfferOwner, offerName, "")
+	result, err := client.Offer(ctx, input.ModelUUID, input.ApplicationName, input.Endpoints, input.OfferOwner, offerName, input.Description)
 	if err != nil {
 		return nil, append(errs, err)
 	}
@@ -226,13 +280,7 @@ func (c *offersClient) CreateOffer(ctx context.Context, input *CreateOfferInput)
 
 // ReadOffer reads offer managed by the offer resource.
 func (c *offersClient) ReadOffer(ctx context.Context, input *ReadOfferInput) (*ReadOfferResponse, error) {
-	var conn api.Connection
-	var err error
-	if input.OfferingController != "" {
-		conn, err = c.GetOfferingControllerConn(ctx, input.OfferingController)
-	} else {
-		conn, err = c.GetConnection(ctx, nil)
-	}
+	conn, err := c.offerControllerConnection(ctx, input.OfferingController)
 	if err != nil {
 		return nil, err
 	}
@@ -259,10 +307,17 @@ func (c *offersClient) ReadOffer(ctx context.Context, input *ReadOfferInput) (*R
 	response.Name = result.OfferName
 	response.ApplicationName = result.ApplicationName
 	response.OfferURL = resultURL.String()
+	response.Description = result.ApplicationDescription
 	for _, endpoint := range result.Endpoints {
 		response.Endpoints = append(response.Endpoints, endpoint.Name)
+		response.EndpointDetails = append(response.EndpointDetails, CharmEndpoint{
+			Name:      endpoint.Name,
+			Interface: endpoint.Interface,
+			Role:      string(endpoint.Role),
+		})
 	}
 	response.Users = result.Users
+	response.Connections = offerConnections(result.Connections)
 
 	if input.GetModelUUID {
 		response.ModelUUID, err = c.ModelUUID(ctx, resultURL.ModelName, resultURL.ModelQualifier)
@@ -274,6 +329,51 @@ func (c *offersClient) ReadOffer(ctx context.Context, input *ReadOfferInput) (*R
 	return &response, nil
 }
 
+// SupportsOfferUpdate reports whether the controller supports updating the
+// endpoints and description of an existing offer. Juju 4 controllers do not.
+func (c *offersClient) SupportsOfferUpdate(ctx context.Context) (bool, error) {
+	version, err := c.GetControllerVersion(ctx)
+	if err != nil {
+		return false, err
+	}
+	return version.Major < 4, nil
+}
+
+// UpdateOffer updates the endpoints and description of an existing offer.
+// OfferUpdateNotSupportedError is returned if the controller does not
+// support updating offers.
+func (c *offersClient) UpdateOffer(ctx context.Context, input *UpdateOfferInput) error {
+	supported, err := c.SupportsOfferUpdate(ctx)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return OfferUpdateNotSupportedError
+	}
+
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := applicationoffers.NewClient(conn)
+
+	// Offering an application again under an existing offer name updates
+	// the offer.
+	results, err := client.Offer(ctx, input.ModelUUID, input.ApplicationName, input.Endpoints, input.OfferOwner, input.Name, input.Description)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, result := range results {
+		if result.Error != nil {
+			errs = append(errs, result.Error)
+		}
+	}
+	return stderr.Join(errs...)
+}
+
 // DestroyOffer makes a single attempt to destroy an offer.
 //
 // If the offer still has active connections, OfferHasConnectionsError is
@@ -312,6 +412,37 @@ func (c *offersClient) DestroyOffer(ctx context.Context, input *DestroyOfferInpu
 	return nil
 }
 
+// offerControllerConnection returns a connection to the controller hosting
+// an offer: the named offering controller, or the provider's controller
+// if no name is given.
+func (c *offersClient) offerControllerConnection(ctx context.Context, offeringController string) (api.Connection, error) {
+	if offeringController != "" {
+		return c.GetOfferingControllerConn(ctx, offeringController)
+	}
+	return c.GetConnection(ctx, nil)
+}
+
+// offerConnections converts the connections of an offer into the
+// provider's representation.
+func offerConnections(connections []crossmodel.OfferConnection) []OfferConnection {
+	if len(connections) == 0 {
+		return nil
+	}
+	result := make([]OfferConnection, 0, len(connections))
+	for _, conn := range connections {
+		result = append(result, OfferConnection{
+			SourceModelUUID: conn.SourceModelUUID,
+			Username:        conn.Username,
+			RelationID:      conn.RelationId,
+			Endpoint:        conn.Endpoint,
+			Status:          string(conn.Status),
+			Message:         conn.Message,
+			IngressSubnets:  conn.IngressSubnets,
+		})
+	}
+	return result
+}
+
 // matchByEndpoints is returning offers that match exactly the endpoints' names provided.
 // If no endpoints are provided, all offers are returned to match the API behaviour.
 // The reason why we rely on this custom matching and not the API filtering is that
@@ -400,12 +531,7 @@ func (c *offersClient) ConsumeRemoteOffer(ctx context.Context, input *ConsumeRem
 		return nil, err
 	}
 	defer func() { _ = modelConn.Close() }()
-	var conn api.Connection
-	if input.OfferingController != "" {
-		conn, err = c.GetOfferingControllerConn(ctx, input.OfferingController)
-	} else {
-		conn, err = c.GetConnection(ctx, nil)
-	}
+	conn, err := c.offerControllerConnection(ctx, input.OfferingController)
 	if err != nil {
 		return nil, err
 	}
@@ -567,7 +693,7 @@ func (c *offersClient) RemoveRemoteApp(ctx context.Context, input *RemoveRemoteA
 // GrantOffer adds access to an offer managed by the access offer resource.
 // No action or error is returned if the access was already granted to the user.
 func (c *offersClient) GrantOffer(ctx context.Context, input *GrantRevokeOfferInput) error {
-	conn, err := c.GetConnection(ctx, nil)
+	conn, err := c.offerControllerConnection(ctx, input.OfferingController)
 	if err != nil {
 		return err
 	}
@@ -589,7 +715,7 @@ func (c *offersClient) GrantOffer(ctx context.Context, input *GrantRevokeOfferIn
 // No action or error if the access was already revoked for the user.
 // Note: revoking `ReadAccess` will remove all access levels for the offer
 func (c *offersClient) RevokeOffer(ctx context.Context, input *GrantRevokeOfferInput) error {
-	conn, err := c.GetConnection(ctx, nil)
+	conn, err := c.offerControllerConnection(ctx, input.OfferingController)
 	if err != nil {
 		return err
 	}
@@ -678,6 +804,8 @@ func (c offersClient) ListOffers(ctx context.Context, input *ListOffersInput) ([
 			Endpoints:       endpoints,
 			OfferURL:        resultURL.String(),
 			ModelUUID:       modelUUID,
+			Description:     offer.ApplicationDescription,
+			Connections:     offerConnections(offer.Connections),
 		}
 
 		// If OfferURL filter is set, only include exact matches
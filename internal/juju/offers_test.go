@@ -135,3 +135,31 @@ func TestMatchByEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestOfferConnections(t *testing.T) {
+	assert.Nil(t, offerConnections(nil))
+
+	connections := offerConnections([]crossmodel.OfferConnection{
+		{
+			SourceModelUUID: "e9c8b0a8-6b1c-4f36-8d3c-5e0b9f0d7a11",
+			Username:        "bob",
+			RelationId:      3,
+			Endpoint:        "db",
+			Status:          "suspended",
+			Message:         "maintenance",
+			IngressSubnets:  []string{"10.0.0.0/24"},
+		},
+	})
+	assert.Equal(t, []OfferConnection{
+		{
+			SourceModelUUID: "e9c8b0a8-6b1c-4f36-8d3c-5e0b9f0d7a11",
+			Username:        "bob",
+			RelationID:      3,
+			Endpoint:        "db",
+			Status:          "suspended",
+			Message:         "maintenance",
+			IngressSubnets:  []string{"10.0.0.0/24"},
+		},
+	}, connections)
+	assert.True(t, connections[0].Suspended())
+}
@@ -4,9 +4,14 @@
 package juju
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -18,6 +23,18 @@ var (
 	ErrSecretBackendNotFound = errors.New("secret backend not found")
 )
 
+const (
+	// VaultBackendType is the type of secret backends storing secrets in Vault.
+	VaultBackendType = "vault"
+	// KubernetesBackendType is the type of secret backends storing secrets
+	// in Kubernetes.
+	KubernetesBackendType = "kubernetes"
+)
+
+// secretBackendCheckTimeout bounds the requests made to a secret backend
+// to check it is reachable.
+const secretBackendCheckTimeout = 30 * time.Second
+
 type secretBackendsClient struct {
 	SharedClient
 }
@@ -190,3 +207,132 @@ func (c *secretBackendsClient) ListSecretBackends(ctx context.Context, input Lis
 
 	return result, nil
 }
+
+// VaultAppRoleLoginInput is the input to VaultAppRoleLogin.
+type VaultAppRoleLoginInput struct {
+	// Endpoint is the address of the Vault server.
+	Endpoint string
+	// Namespace is the Vault namespace the AppRole belongs to. Optional.
+	Namespace string
+	// CACert is the PEM encoded CA certificate of the Vault server. Optional.
+	CACert string
+	// RoleID is the role ID of the AppRole.
+	RoleID string
+	// SecretID is the secret ID of the AppRole.
+	SecretID string
+}
+
+// VaultAppRoleLogin logs in to Vault with an AppRole and returns the client
+// token issued by Vault. Juju only authenticates to Vault with a token, so
+// the token is what gets handed over to the secret backend configuration.
+func (c *secretBackendsClient) VaultAppRoleLogin(ctx context.Context, input VaultAppRoleLoginInput) (string, error) {
+	httpClient, err := secretBackendHTTPClient(input.CACert)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(map[string]string{
+		"role_id":   input.RoleID,
+		"secret_id": input.SecretID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, secretBackendCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		strings.TrimSuffix(input.Endpoint, "/")+"/v1/auth/approle/login", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	if input.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", input.Namespace)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("logging in to vault: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("logging in to vault: unexpected status %q", resp.Status)
+	}
+
+	var login struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&login); err != nil {
+		return "", fmt.Errorf("decoding vault login response: %w", err)
+	}
+	if login.Auth.ClientToken == "" {
+		return "", errors.New("vault login response does not contain a client token")
+	}
+	return login.Auth.ClientToken, nil
+}
+
+// CheckSecretBackendReachable checks the endpoint of a vault or kubernetes
+// secret backend answers, using the endpoint and ca-cert keys of the backend
+// configuration. Other backend types, or configurations without an endpoint,
+// are not checked.
+func (c *secretBackendsClient) CheckSecretBackendReachable(ctx context.Context, backendType string, config map[string]any) error {
+	endpoint, _ := config["endpoint"].(string)
+	if endpoint == "" {
+		return nil
+	}
+	caCert, _ := config["ca-cert"].(string)
+
+	var checkPath string
+	switch backendType {
+	case VaultBackendType:
+		// Standby nodes are reachable too, they forward requests to the
+		// active node.
+		checkPath = "/v1/sys/health?standbyok=true&perfstandbyok=true"
+	case KubernetesBackendType:
+		checkPath = "/version"
+	default:
+		return nil
+	}
+
+	httpClient, err := secretBackendHTTPClient(caCert)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, secretBackendCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(endpoint, "/")+checkPath, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s secret backend at %q is not reachable: %w", backendType, endpoint, err)
+	}
+	_ = resp.Body.Close()
+
+	switch {
+	case backendType == VaultBackendType && resp.StatusCode == http.StatusNotImplemented:
+		return fmt.Errorf("vault at %q is not initialized", endpoint)
+	case backendType == VaultBackendType && resp.StatusCode == http.StatusServiceUnavailable:
+		return fmt.Errorf("vault at %q is sealed", endpoint)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("%s secret backend at %q is not healthy: unexpected status %q", backendType, endpoint, resp.Status)
+	}
+	// Any other answer, including authorization failures from Kubernetes,
+	// shows the endpoint is reachable.
+	return nil
+}
+
+// secretBackendHTTPClient returns an HTTP client trusting the given PEM
+// encoded CA certificate, or the system roots when it is empty.
+func secretBackendHTTPClient(caCert string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, errors.New("invalid CA certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Transport: transport}, nil
+}
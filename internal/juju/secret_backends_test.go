@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSecretBackendReachableVault(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/sys/health", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("standbyok"))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := &secretBackendsClient{}
+	config := map[string]any{"endpoint": server.URL}
+
+	assert.NoError(t, client.CheckSecretBackendReachable(t.Context(), VaultBackendType, config))
+
+	status = http.StatusServiceUnavailable
+	assert.ErrorContains(t, client.CheckSecretBackendReachable(t.Context(), VaultBackendType, config), "is sealed")
+
+	status = http.StatusNotImplemented
+	assert.ErrorContains(t, client.CheckSecretBackendReachable(t.Context(), VaultBackendType, config), "is not initialized")
+}
+
+func TestCheckSecretBackendReachableKubernetes(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/version", r.URL.Path)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := &secretBackendsClient{}
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	// An unauthorized answer still shows the endpoint is reachable.
+	err := client.CheckSecretBackendReachable(t.Context(), KubernetesBackendType, map[string]any{
+		"endpoint": server.URL,
+		"ca-cert":  caCert,
+	})
+	assert.NoError(t, err)
+
+	// Without the CA certificate the server is not trusted.
+	err = client.CheckSecretBackendReachable(t.Context(), KubernetesBackendType, map[string]any{
+		"endpoint": server.URL,
+	})
+	assert.ErrorContains(t, err, "is not reachable")
+}
+
+func TestCheckSecretBackendReachableSkipped(t *testing.T) {
+	client := &secretBackendsClient{}
+	assert.NoError(t, client.CheckSecretBackendReachable(t.Context(), "internal", map[string]any{"endpoint": "http://127.0.0.1:0"}))
+	assert.NoError(t, client.CheckSecretBackendReachable(t.Context(), VaultBackendType, map[string]any{}))
+}
+
+func TestVaultAppRoleLogin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/auth/approle/login", r.URL.Path)
+		assert.Equal(t, "team-a", r.Header.Get("X-Vault-Namespace"))
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body["role_id"] != "role" || body["secret_id"] != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"auth": {"client_token": "s.token"}}`))
+	}))
+	defer server.Close()
+
+	client := &secretBackendsClient{}
+	input := VaultAppRoleLoginInput{
+		Endpoint:  server.URL,
+		Namespace: "team-a",
+		RoleID:    "role",
+		SecretID:  "secret",
+	}
+	token, err := client.VaultAppRoleLogin(t.Context(), input)
+	require.NoError(t, err)
+	assert.Equal(t, "s.token", token)
+
+	input.SecretID = "wrong"
+	_, err = client.VaultAppRoleLogin(t.Context(), input)
+	assert.ErrorContains(t, err, "unexpected status")
+}
@@ -8,7 +8,9 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	jujuerrors "github.com/juju/errors"
 	"github.com/juju/juju/api"
@@ -53,12 +55,20 @@ const (
 	RevokeAccess
 )
 
+// Base64Suffix marks a secret value key whose value is already base64
+// encoded, following the Juju CLI convention. The suffix is removed from
+// the key before the value is stored.
+const Base64Suffix = "#base64"
+
 // CreateSecretInput contains the parameters for creating a secret.
 type CreateSecretInput struct {
 	ModelUUID string
 	Name      string
+	// Value holds the content of the secret. Values of keys with the
+	// Base64Suffix are sent as is, all other values are base64 encoded.
 	Value     map[string]string
 	Info      string
+	AutoPrune bool
 }
 
 // CreateSecretOutput contains the identifiers for a created secret.
@@ -77,12 +87,25 @@ type ReadSecretInput struct {
 
 // ReadSecretOutput contains the secret data returned by a read request.
 type ReadSecretOutput struct {
-	SecretId     string
-	SecretURI    string
-	Name         string
-	Value        map[string]string
-	Applications []string
-	Info         string
+	SecretId  string
+	SecretURI string
+	Name      string
+	Value     map[string]string
+	// EncodedValue holds the content of the secret as stored by Juju,
+	// base64 encoded.
+	EncodedValue    map[string]string
+	Applications    []string
+	Info            string
+	AutoPrune       bool
+	CurrentRevision int
+	Revisions       []SecretRevision
+}
+
+// SecretRevision describes a single revision of a secret.
+type SecretRevision struct {
+	Revision int
+	Created  time.Time
+	Expires  *time.Time
 }
 
 // ListSecretsInput is the input for ListSecrets.
@@ -100,6 +123,10 @@ type ListSecretsOutput struct {
 	Applications []string
 	Info         string
 	BackendName  string
+	AutoPrune    bool
+	// CurrentRevision is the latest revision of the secret.
+	CurrentRevision int
+	Revisions       []SecretRevision
 }
 
 // UpdateSecretInput contains the parameters for updating a secret.
@@ -116,6 +143,19 @@ type UpdateSecretInput struct {
 type DeleteSecretInput struct {
 	SecretId  string
 	ModelUUID string
+	// Revision, when set, removes only the given revision of the secret
+	// rather than the whole secret.
+	Revision *int
+}
+
+// PruneSecretRevisionsInput contains the parameters for removing old
+// revisions of a secret.
+type PruneSecretRevisionsInput struct {
+	SecretId  string
+	ModelUUID string
+	// OlderThan is the revision number below which revisions are removed.
+	// The current revision is never removed.
+	OlderThan int
 }
 
 // GrantRevokeAccessSecretInput contains the parameters for access updates.
@@ -158,10 +198,9 @@ func (c *secretsClient) CreateSecret(ctx context.Context, input *CreateSecretInp
 
 	secretAPIClient := c.getSecretAPIClient(conn)
 
-	// Encode the secret values as base64
-	encodedValue := make(map[string]string, len(input.Value))
-	for k, v := range input.Value {
-		encodedValue[k] = base64.StdEncoding.EncodeToString([]byte(v))
+	encodedValue, err := encodeSecretValue(input.Value)
+	if err != nil {
+		return CreateSecretOutput{}, err
 	}
 
 	secretId, err := secretAPIClient.CreateSecret(ctx, input.Name, input.Info, encodedValue)
@@ -172,10 +211,21 @@ func (c *secretsClient) CreateSecret(ctx context.Context, input *CreateSecretInp
 	if err != nil {
 		return CreateSecretOutput{}, typedError(err)
 	}
-	return CreateSecretOutput{
+	output := CreateSecretOutput{
 		SecretId:  secretURI.ID,
 		SecretURI: secretURI.String(),
-	}, nil
+	}
+	// Auto pruning cannot be requested on creation, it can only be
+	// enabled by a subsequent update. The secret exists by then, so it is
+	// returned along with the error.
+	if input.AutoPrune {
+		autoPrune := true
+		err = secretAPIClient.UpdateSecret(ctx, secretURI, "", &autoPrune, "", "", map[string]string{})
+		if err != nil {
+			return output, fmt.Errorf("enabling auto prune: %w", typedError(err))
+		}
+	}
+	return output, nil
 }
 
 // ReadSecret reads a secret.
@@ -224,15 +274,47 @@ func (c *secretsClient) ReadSecret(ctx context.Context, input *ReadSecretInput)
 	applications := getApplicationsFromAccessInfo(results[0].Access)
 
 	return ReadSecretOutput{
-		SecretId:     results[0].Metadata.URI.ID,
-		SecretURI:    results[0].Metadata.URI.String(),
-		Name:         results[0].Metadata.Label,
-		Value:        decodedValue,
-		Applications: applications,
-		Info:         results[0].Metadata.Description,
+		SecretId:        results[0].Metadata.URI.ID,
+		SecretURI:       results[0].Metadata.URI.String(),
+		Name:            results[0].Metadata.Label,
+		Value:           decodedValue,
+		EncodedValue:    results[0].Value.EncodedValues(),
+		Applications:    applications,
+		Info:            results[0].Metadata.Description,
+		AutoPrune:       results[0].Metadata.AutoPrune,
+		CurrentRevision: results[0].Metadata.LatestRevision,
+		Revisions:       secretRevisions(results[0].Revisions),
 	}, nil
 }
 
+// ReadSecretAccess returns the applications granted access to a secret,
+// without revealing its content.
+func (c *secretsClient) ReadSecretAccess(ctx context.Context, input *ReadSecretInput) ([]string, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	secretURI, err := coresecrets.ParseURI(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	secretAPIClient := c.getSecretAPIClient(conn)
+	results, err := secretAPIClient.ListSecrets(ctx, false, coresecrets.Filter{URI: secretURI})
+	if err != nil {
+		return nil, typedError(err)
+	}
+	if len(results) < 1 {
+		return nil, &secretNotFoundError{secretId: input.SecretId}
+	}
+	if results[0].Error != "" {
+		return nil, errors.New(results[0].Error)
+	}
+	return getApplicationsFromAccessInfo(results[0].Access), nil
+}
+
 // ListSecrets lists secrets in a model.
 func (c *secretsClient) ListSecrets(ctx context.Context, input *ListSecretsInput) ([]ListSecretsOutput, error) {
 	conn, err := c.GetConnection(ctx, &input.ModelUUID)
@@ -272,13 +354,16 @@ func (c *secretsClient) ListSecrets(ctx context.Context, input *ListSecretsInput
 		}
 
 		output = append(output, ListSecretsOutput{
-			SecretId:     result.Metadata.URI.ID,
-			SecretURI:    result.Metadata.URI.String(),
-			Name:         result.Metadata.Label,
-			Value:        decodedValue,
-			Applications: applications,
-			Info:         result.Metadata.Description,
-			BackendName:  backendName,
+			SecretId:        result.Metadata.URI.ID,
+			SecretURI:       result.Metadata.URI.String(),
+			Name:            result.Metadata.Label,
+			Value:           decodedValue,
+			Applications:    applications,
+			Info:            result.Metadata.Description,
+			BackendName:     backendName,
+			AutoPrune:       result.Metadata.AutoPrune,
+			CurrentRevision: result.Metadata.LatestRevision,
+			Revisions:       secretRevisions(result.Revisions),
 		})
 	}
 
@@ -309,13 +394,10 @@ func (c *secretsClient) UpdateSecret(ctx context.Context, input *UpdateSecretInp
 	}
 	var value map[string]string
 	if input.Value != nil {
-		// Encode the secret values as base64
-		encodedValue := make(map[string]string, len(*input.Value))
-		for k, v := range *input.Value {
-			encodedValue[k] = base64.StdEncoding.EncodeToString([]byte(v))
+		value, err = encodeSecretValue(*input.Value)
+		if err != nil {
+			return err
 		}
-
-		value = encodedValue
 	} else {
 		value = map[string]string{}
 	}
@@ -358,8 +440,7 @@ func (c *secretsClient) DeleteSecret(ctx context.Context, input *DeleteSecretInp
 	if err != nil {
 		return err
 	}
-	// TODO: think about removing concrete revision.
-	err = secretAPIClient.RemoveSecret(ctx, secretURI, "", nil)
+	err = secretAPIClient.RemoveSecret(ctx, secretURI, "", input.Revision)
 	if !errors.Is(err, jujuerrors.NotFound) {
 		return typedError(err)
 	}
@@ -367,6 +448,52 @@ func (c *secretsClient) DeleteSecret(ctx context.Context, input *DeleteSecretInp
 	return nil
 }
 
+// PruneSecretRevisions removes every revision of a secret older than the
+// given revision number, keeping the current revision. It returns the
+// revisions which were removed.
+func (c *secretsClient) PruneSecretRevisions(ctx context.Context, input *PruneSecretRevisionsInput) ([]int, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	secretAPIClient := c.getSecretAPIClient(conn)
+
+	secretURI, err := coresecrets.ParseURI(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := secretAPIClient.ListSecrets(ctx, false, coresecrets.Filter{URI: secretURI})
+	if err != nil {
+		return nil, typedError(err)
+	}
+	if len(results) < 1 {
+		return nil, &secretNotFoundError{secretId: input.SecretId}
+	}
+	if results[0].Error != "" {
+		return nil, errors.New(results[0].Error)
+	}
+
+	var removed []int
+	for _, revision := range results[0].Revisions {
+		if revision.Revision >= input.OlderThan || revision.Revision == results[0].Metadata.LatestRevision {
+			continue
+		}
+		err = secretAPIClient.RemoveSecret(ctx, secretURI, "", &revision.Revision)
+		if errors.Is(err, jujuerrors.NotFound) {
+			// Removed concurrently, nothing was pruned.
+			continue
+		}
+		if err != nil {
+			return removed, typedError(err)
+		}
+		removed = append(removed, revision.Revision)
+	}
+	return removed, nil
+}
+
 // UpdateAccessSecret updates access to a secret.
 func (c *secretsClient) UpdateAccessSecret(ctx context.Context, input *GrantRevokeAccessSecretInput, op AccessSecretAction) error {
 	conn, err := c.GetConnection(ctx, &input.ModelUUID)
@@ -412,3 +539,52 @@ func getApplicationsFromAccessInfo(accessInfo []coresecrets.AccessInfo) []string
 	}
 	return applications
 }
+
+// encodeSecretValue base64 encodes the content of a secret as expected by
+// Juju. Keys with the Base64Suffix hold values which are already encoded,
+// the suffix is removed and the value is checked and kept as is.
+func encodeSecretValue(value map[string]string) (map[string]string, error) {
+	encoded := make(map[string]string, len(value))
+	for k, v := range value {
+		key, isEncoded := strings.CutSuffix(k, Base64Suffix)
+		if _, ok := encoded[key]; ok {
+			return nil, fmt.Errorf("secret key %q is specified more than once", key)
+		}
+		if isEncoded {
+			if _, err := base64.StdEncoding.DecodeString(v); err != nil {
+				return nil, fmt.Errorf("value of secret key %q is not valid base64: %w", key, err)
+			}
+			encoded[key] = v
+			continue
+		}
+		encoded[key] = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+	return encoded, nil
+}
+
+// secretRevisions converts the revision metadata of a secret, sorted
+// by revision number.
+func secretRevisions(metadata []coresecrets.SecretRevisionMetadata) []SecretRevision {
+	revisions := make([]SecretRevision, 0, len(metadata))
+	for _, rev := range metadata {
+		revisions = append(revisions, SecretRevision{
+			Revision: rev.Revision,
+			Created:  rev.CreateTime,
+			Expires:  rev.ExpireTime,
+		})
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	return revisions
+}
+
+// IsSecretURI returns true if the given value is a Juju secret URI, e.g.
+// "secret:d1mhdjfmp25c77ccbm3g".
+func IsSecretURI(value string) bool {
+	if !strings.HasPrefix(value, coresecrets.SecretScheme+":") {
+		return false
+	}
+	_, err := coresecrets.ParseURI(value)
+	return err == nil
+}
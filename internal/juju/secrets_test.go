@@ -4,10 +4,12 @@
 package juju
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"testing"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/juju/api"
 	apisecrets "github.com/juju/juju/api/client/secrets"
 	coresecrets "github.com/juju/juju/core/secrets"
@@ -69,6 +71,34 @@ func (s *SecretSuite) TestCreateSecret() {
 	s.Assert().Equal(secretURI.ID, output.SecretId)
 }
 
+func (s *SecretSuite) TestCreateSecretAutoPruneError() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	encodedValue := map[string]string{"key": base64.StdEncoding.EncodeToString([]byte("value"))}
+
+	secretId := "secret:9m4e2mr0ui3e8a215n4g"
+	secretURI, err := coresecrets.ParseURI(secretId)
+	s.Require().NoError(err)
+	s.mockSecretClient.EXPECT().CreateSecret(gomock.Any(),
+		"test-secret", "test info", encodedValue,
+	).Return(secretURI.ID, nil)
+	s.mockSecretClient.EXPECT().UpdateSecret(gomock.Any(), gomock.Any(), "", gomock.Any(), "", "", gomock.Any()).
+		Return(errors.New("boom"))
+
+	client := s.getSecretsClient()
+	output, err := client.CreateSecret(s.T().Context(), &CreateSecretInput{
+		ModelUUID: *s.testModelName,
+		Name:      "test-secret",
+		Value:     map[string]string{"key": "value"},
+		Info:      "test info",
+		AutoPrune: true,
+	})
+	s.Require().ErrorContains(err, "enabling auto prune: boom")
+	// The secret exists, its ID is returned along with the error.
+	s.Assert().Equal(secretURI.ID, output.SecretId)
+}
+
 func (s *SecretSuite) TestCreateSecretError() {
 	ctlr := s.setupMocks(s.T())
 	defer ctlr.Finish()
@@ -142,6 +172,35 @@ func (s *SecretSuite) TestReadSecret() {
 	s.Assert().Equal("value", output.Value["key"])
 }
 
+func (s *SecretSuite) TestReadSecretAccess() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	secretId := "secret:9m4e2mr0ui3e8a215n4g"
+	secretURI, err := coresecrets.ParseURI(secretId)
+	s.Require().NoError(err)
+
+	// The content of the secret is not revealed.
+	s.mockSecretClient.EXPECT().ListSecrets(gomock.Any(),
+		false, coresecrets.Filter{URI: secretURI},
+	).Return([]apisecrets.SecretDetails{
+		{
+			Metadata: coresecrets.SecretMetadata{URI: secretURI},
+			Access: []coresecrets.AccessInfo{
+				{Target: "application-mysql", Scope: "model-" + *s.testModelName, Role: coresecrets.RoleView},
+			},
+		},
+	}, nil)
+
+	client := s.getSecretsClient()
+	applications, err := client.ReadSecretAccess(s.T().Context(), &ReadSecretInput{
+		SecretId:  secretId,
+		ModelUUID: *s.testModelName,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"mysql"}, applications)
+}
+
 func (s *SecretSuite) TestReadSecretError() {
 	ctlr := s.setupMocks(s.T())
 	defer ctlr.Finish()
@@ -311,6 +370,124 @@ func (s *SecretSuite) TestDeleteSecret() {
 	s.Assert().NoError(err)
 }
 
+func (s *SecretSuite) TestDeleteSecretRevision() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	secretId := "secret:9m4e2mr0ui3e8a215n4g"
+	secretURI, err := coresecrets.ParseURI(secretId)
+	s.Require().NoError(err)
+	revision := 2
+
+	s.mockSecretClient.EXPECT().RemoveSecret(gomock.Any(), secretURI, "", &revision).Return(nil)
+
+	client := s.getSecretsClient()
+	err = client.DeleteSecret(s.T().Context(), &DeleteSecretInput{
+		SecretId:  secretId,
+		ModelUUID: *s.testModelName,
+		Revision:  &revision,
+	})
+	s.Assert().NoError(err)
+}
+
+func (s *SecretSuite) TestPruneSecretRevisions() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	secretId := "secret:9m4e2mr0ui3e8a215n4g"
+	secretURI, err := coresecrets.ParseURI(secretId)
+	s.Require().NoError(err)
+
+	s.mockSecretClient.EXPECT().ListSecrets(gomock.Any(), false, coresecrets.Filter{URI: secretURI}).Return(
+		[]apisecrets.SecretDetails{{
+			Metadata: coresecrets.SecretMetadata{
+				URI:            secretURI,
+				LatestRevision: 3,
+			},
+			Revisions: []coresecrets.SecretRevisionMetadata{
+				{Revision: 1}, {Revision: 2}, {Revision: 3},
+			},
+		}}, nil)
+	s.mockSecretClient.EXPECT().RemoveSecret(gomock.Any(), secretURI, "", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *coresecrets.URI, _ string, revision *int) error {
+			s.Assert().Equal(1, *revision)
+			return nil
+		})
+
+	client := s.getSecretsClient()
+	removed, err := client.PruneSecretRevisions(s.T().Context(), &PruneSecretRevisionsInput{
+		SecretId:  secretId,
+		ModelUUID: *s.testModelName,
+		OlderThan: 2,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]int{1}, removed)
+}
+
+func (s *SecretSuite) TestPruneSecretRevisionsSkipsRevisionsAlreadyRemoved() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	secretId := "secret:9m4e2mr0ui3e8a215n4g"
+	secretURI, err := coresecrets.ParseURI(secretId)
+	s.Require().NoError(err)
+
+	s.mockSecretClient.EXPECT().ListSecrets(gomock.Any(), false, coresecrets.Filter{URI: secretURI}).Return(
+		[]apisecrets.SecretDetails{{
+			Metadata: coresecrets.SecretMetadata{
+				URI:            secretURI,
+				LatestRevision: 3,
+			},
+			Revisions: []coresecrets.SecretRevisionMetadata{
+				{Revision: 1}, {Revision: 2}, {Revision: 3},
+			},
+		}}, nil)
+	s.mockSecretClient.EXPECT().RemoveSecret(gomock.Any(), secretURI, "", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *coresecrets.URI, _ string, revision *int) error {
+			if *revision == 1 {
+				return jujuerrors.NotFoundf("secret revision 1")
+			}
+			return nil
+		}).Times(2)
+
+	client := s.getSecretsClient()
+	removed, err := client.PruneSecretRevisions(s.T().Context(), &PruneSecretRevisionsInput{
+		SecretId:  secretId,
+		ModelUUID: *s.testModelName,
+		OlderThan: 3,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]int{2}, removed)
+}
+
+func (s *SecretSuite) TestEncodeSecretValue() {
+	encoded, err := encodeSecretValue(map[string]string{
+		"plain":         "value",
+		"binary#base64": "AAEC",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(map[string]string{
+		"plain":  base64.StdEncoding.EncodeToString([]byte("value")),
+		"binary": "AAEC",
+	}, encoded)
+
+	_, err = encodeSecretValue(map[string]string{"binary#base64": "not base64!"})
+	s.Assert().ErrorContains(err, `value of secret key "binary" is not valid base64`)
+
+	_, err = encodeSecretValue(map[string]string{"key": "a", "key#base64": "Yg=="})
+	s.Assert().ErrorContains(err, `secret key "key" is specified more than once`)
+}
+
+func (s *SecretSuite) TestSecretRevisionsSorted() {
+	revisions := secretRevisions([]coresecrets.SecretRevisionMetadata{
+		{Revision: 3}, {Revision: 1}, {Revision: 2},
+	})
+	s.Require().Len(revisions, 3)
+	s.Assert().Equal(1, revisions[0].Revision)
+	s.Assert().Equal(2, revisions[1].Revision)
+	s.Assert().Equal(3, revisions[2].Revision)
+}
+
 func (s *SecretSuite) TestUpdateAccessSecret() {
 	ctlr := s.setupMocks(s.T())
 	defer ctlr.Finish()
@@ -340,6 +517,14 @@ func (s *SecretSuite) TestUpdateAccessSecret() {
 	s.Require().NoError(err)
 }
 
+func (s *SecretSuite) TestIsSecretURI() {
+	s.True(IsSecretURI("secret:d1mhdjfmp25c77ccbm3g"))
+	s.True(IsSecretURI("secret://" + "3e4f9d39-2f84-4a2a-8c36-9d0c1a8a3c5e" + "/d1mhdjfmp25c77ccbm3g"))
+	s.False(IsSecretURI("d1mhdjfmp25c77ccbm3g"))
+	s.False(IsSecretURI("secret:not a secret"))
+	s.False(IsSecretURI("postgresql://10.0.0.1:5432"))
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestUserSecretSuite(t *testing.T) {
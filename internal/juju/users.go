@@ -5,9 +5,14 @@ package juju
 
 import (
 	"context"
+	"encoding/asn1"
+	"encoding/base64"
 	"fmt"
 
+	"github.com/juju/errors"
 	"github.com/juju/juju/api/client/usermanager"
+	controllerapi "github.com/juju/juju/api/controller/controller"
+	"github.com/juju/juju/api/jujuclient"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v6"
 )
@@ -28,6 +33,9 @@ type CreateUserInput struct {
 type CreateUserResponse struct {
 	UserTag names.UserTag
 	Secret  []byte
+	// RegistrationString is the token to pass to `juju register`. It is
+	// only set when the user is created without a password.
+	RegistrationString string
 }
 
 // ReadUserInput contains the parameters for reading a user.
@@ -58,6 +66,21 @@ type DestroyUserInput struct {
 	Name string
 }
 
+// GrantControllerInput contains the parameters for granting a user
+// access to the controller.
+type GrantControllerInput struct {
+	User   string
+	Access string
+}
+
+// RevokeControllerInput contains the parameters for revoking a user's
+// access to the controller. Revoking an access level leaves the user
+// with the level below it, revoking `login` removes all access.
+type RevokeControllerInput struct {
+	User   string
+	Access string
+}
+
 func newUsersClient(sc SharedClient) *usersClient {
 	return &usersClient{
 		SharedClient: sc,
@@ -72,6 +95,17 @@ func (c *usersClient) CreateUser(ctx context.Context, input CreateUserInput) (*C
 	}
 	defer func() { _ = conn.Close() }()
 
+	// The controller name is looked up before adding the user, so that a
+	// failure does not leave a user without its registration string.
+	var controllerName string
+	if input.Password == "" {
+		ctrlConfig, err := controllerapi.NewClient(conn).ControllerConfig(ctx)
+		if err != nil {
+			return nil, errors.Annotate(err, "reading controller name")
+		}
+		controllerName = ctrlConfig.ControllerName()
+	}
+
 	client := usermanager.NewClient(conn)
 
 	userTag, userSecret, err := client.AddUser(ctx, input.Name, input.DisplayName, input.Password)
@@ -79,7 +113,18 @@ func (c *usersClient) CreateUser(ctx context.Context, input CreateUserInput) (*C
 		return nil, err
 	}
 
-	return &CreateUserResponse{UserTag: userTag, Secret: userSecret}, nil
+	response := &CreateUserResponse{UserTag: userTag, Secret: userSecret}
+	if input.Password == "" {
+		var addrs []string
+		if addr := conn.Addr(); addr != nil {
+			addrs = append(addrs, addr.Host)
+		}
+		response.RegistrationString, err = registrationString(userTag.Id(), controllerName, addrs, userSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
 }
 
 // ReadUser retrieves details for the named user.
@@ -92,7 +137,7 @@ func (c *usersClient) ReadUser(ctx context.Context, name string) (*ReadUserRespo
 
 	usermanagerClient := usermanager.NewClient(usermanagerConn)
 
-	users, err := usermanagerClient.UserInfo(ctx, []string{name}, usermanager.IncludeDisabled(false)) //don't list disabled users
+	users, err := usermanagerClient.UserInfo(ctx, []string{name}, usermanager.IncludeDisabled(true))
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +156,19 @@ func (c *usersClient) ReadUser(ctx context.Context, name string) (*ReadUserRespo
 	}, nil
 }
 
+// ListUsers returns the users of the controller, disabled users
+// included.
+func (c *usersClient) ListUsers(ctx context.Context) ([]params.UserInfo, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := usermanager.NewClient(conn)
+	return client.UserInfo(ctx, nil, usermanager.AllUsers)
+}
+
 // ModelUserInfo lists users and access for the specified model.
 func (c *usersClient) ModelUserInfo(ctx context.Context, modelUUID string) (*ReadModelUserResponse, error) {
 	usermanagerConn, err := c.GetConnection(ctx, nil)
@@ -171,3 +229,98 @@ func (c *usersClient) DestroyUser(ctx context.Context, input DestroyUserInput) e
 
 	return nil
 }
+
+// GrantController grants a user access to the controller.
+func (c *usersClient) GrantController(ctx context.Context, input GrantControllerInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := controllerapi.NewClient(conn)
+
+	return client.GrantController(ctx, input.User, input.Access)
+}
+
+// RevokeController revokes a user's access to the controller.
+func (c *usersClient) RevokeController(ctx context.Context, input RevokeControllerInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := controllerapi.NewClient(conn)
+
+	return client.RevokeController(ctx, input.User, input.Access)
+}
+
+// ControllerAccess returns the access level a user has on the controller.
+// An empty access level is returned for users which do not exist.
+func (c *usersClient) ControllerAccess(ctx context.Context, user string) (string, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := controllerapi.NewClient(conn)
+
+	access, err := client.GetControllerAccess(ctx, user)
+	if errors.Is(err, errors.NotFound) || params.IsCodeNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(access), nil
+}
+
+// DisableUser disables a user, preventing it from logging in.
+func (c *usersClient) DisableUser(ctx context.Context, name string) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := usermanager.NewClient(conn)
+
+	return client.DisableUser(ctx, name)
+}
+
+// EnableUser re-enables a disabled user.
+func (c *usersClient) EnableUser(ctx context.Context, name string) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := usermanager.NewClient(conn)
+
+	return client.EnableUser(ctx, name)
+}
+
+// registrationString returns the token a user passes to `juju register`
+// to set its password and log in, as generated by `juju add-user`.
+func registrationString(user, controllerName string, addrs []string, secretKey []byte) (string, error) {
+	registrationData, err := asn1.Marshal(jujuclient.RegistrationInfo{
+		User:           user,
+		Addrs:          addrs,
+		SecretKey:      secretKey,
+		ControllerName: controllerName,
+	})
+	if err != nil {
+		return "", errors.Annotate(err, "marshalling registration info")
+	}
+
+	// Pad with zero bytes so the URL encoded string has no trailing `=`,
+	// the ASN.1 data being length-encoded the padding is ignored.
+	if remainder := len(registrationData) % 3; remainder != 0 {
+		var pad [3]byte
+		registrationData = append(registrationData, pad[:3-remainder]...)
+	}
+	return base64.URLEncoding.EncodeToString(registrationData), nil
+}
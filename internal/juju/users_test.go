@@ -0,0 +1,30 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"encoding/asn1"
+	"encoding/base64"
+	"testing"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrationString(t *testing.T) {
+	token, err := registrationString("bob", "prod", []string{"10.0.0.1:17070"}, []byte("secret-key"))
+	require.NoError(t, err)
+	assert.NotContains(t, token, "=")
+
+	data, err := base64.URLEncoding.DecodeString(token)
+	require.NoError(t, err)
+	var info jujuclient.RegistrationInfo
+	_, err = asn1.Unmarshal(data, &info)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", info.User)
+	assert.Equal(t, []string{"10.0.0.1:17070"}, info.Addrs)
+	assert.Equal(t, []byte("secret-key"), info.SecretKey)
+	assert.Equal(t, "prod", info.ControllerName)
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ datasource.DataSourceWithConfigure = &cloudDataSource{}
+
+// NewCloudDataSource returns a cloud data source.
+func NewCloudDataSource() datasource.DataSource {
+	return &cloudDataSource{}
+}
+
+type cloudDataSource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for clouds.
+	subCtx context.Context
+}
+
+type cloudDataSourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	AuthTypes        types.Set    `tfsdk:"auth_types"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	IdentityEndpoint types.String `tfsdk:"identity_endpoint"`
+	StorageEndpoint  types.String `tfsdk:"storage_endpoint"`
+	Regions          types.List   `tfsdk:"regions"`
+	Config           types.Map    `tfsdk:"config"`
+}
+
+// Metadata implements datasource.DataSourceWithConfigure.Metadata.
+func (d *cloudDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud"
+}
+
+// Schema implements datasource.DataSourceWithConfigure.Schema.
+func (d *cloudDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing a Juju Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the cloud in Juju.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the cloud.",
+				Computed:    true,
+			},
+			"auth_types": schema.SetAttribute{
+				Description: "List of supported authentication types by the cloud.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "The global endpoint for the cloud.",
+				Computed:    true,
+			},
+			"identity_endpoint": schema.StringAttribute{
+				Description: "The global identity endpoint for the cloud.",
+				Computed:    true,
+			},
+			"storage_endpoint": schema.StringAttribute{
+				Description: "The global storage endpoint for the cloud.",
+				Computed:    true,
+			},
+			"regions": schema.ListNestedAttribute{
+				Description: "List of regions for the cloud. The first region in the list is the default region for the cloud.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the region.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "Region-specific endpoint.",
+							Computed:    true,
+						},
+						"identity_endpoint": schema.StringAttribute{
+							Description: "Region-specific identity endpoint.",
+							Computed:    true,
+						},
+						"storage_endpoint": schema.StringAttribute{
+							Description: "Region-specific storage endpoint.",
+							Computed:    true,
+						},
+						"config": schema.MapAttribute{
+							Description: "Region-specific configuration.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"config": schema.MapAttribute{
+				Description: "Cloud-wide configuration.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.Configure.
+func (d *cloudDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderDataForDataSource(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = provider.Client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceCloud)
+}
+
+// Read implements datasource.DataSourceWithConfigure.Read.
+func (d *cloudDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "cloud")
+		return
+	}
+
+	var data cloudDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := d.client.Clouds.ReadCloud(ctx, juju.ReadCloudInput{Name: data.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read cloud data source %q", data.Name.ValueString()))
+
+	data.Name = types.StringValue(out.Name)
+	data.Type = types.StringValue(out.Type)
+	authTypes, dErr := types.SetValueFrom(ctx, types.StringType, out.AuthTypes)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AuthTypes = authTypes
+	data.Endpoint = types.StringValue(out.Endpoint)
+	data.IdentityEndpoint = types.StringValue(out.IdentityEndpoint)
+	data.StorageEndpoint = types.StringValue(out.StorageEndpoint)
+	data.Regions = flattenRegions(ctx, out.Regions, out.RegionConfig, &resp.Diagnostics)
+	data.Config = flattenCloudConfig(ctx, out.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *cloudDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceCloud, msg, additionalFields...)
+}
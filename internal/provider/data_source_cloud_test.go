@@ -0,0 +1,39 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceCloud(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+
+	dataSourceName := "data.juju_cloud.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCloud("localhost"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "localhost"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "lxd"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "regions.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCloud(name string) string {
+	return `
+data "juju_cloud" "this" {
+  name = "` + name + `"
+}`
+}
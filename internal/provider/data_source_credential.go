@@ -0,0 +1,138 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ datasource.DataSourceWithConfigure = &credentialDataSource{}
+
+// NewCredentialDataSource returns a credential data source.
+func NewCredentialDataSource() datasource.DataSource {
+	return &credentialDataSource{}
+}
+
+type credentialDataSource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for credentials.
+	subCtx context.Context
+}
+
+type credentialDataSourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Cloud    types.String `tfsdk:"cloud"`
+	AuthType types.String `tfsdk:"auth_type"`
+	Models   types.Map    `tfsdk:"models"`
+}
+
+// Metadata implements datasource.DataSourceWithConfigure.Metadata.
+func (d *credentialDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+// Schema implements datasource.DataSourceWithConfigure.Schema.
+func (d *credentialDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing a controller credential for a cloud. The credential" +
+			" attributes are not read, as they hold secrets.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the credential.",
+				Required:    true,
+			},
+			"cloud": schema.StringAttribute{
+				Description: "The name of the cloud the credential is for.",
+				Required:    true,
+			},
+			"auth_type": schema.StringAttribute{
+				Description: "Credential authorization type.",
+				Computed:    true,
+			},
+			"models": schema.MapAttribute{
+				Description: "The models using the credential, mapped to the access the current user has to them.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.Configure.
+func (d *credentialDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderDataForDataSource(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = provider.Client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceCredential)
+}
+
+// Read implements datasource.DataSourceWithConfigure.Read.
+func (d *credentialDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "credential")
+		return
+	}
+
+	var data credentialDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Credentials.ReadCredential(ctx, juju.ReadCredentialInput{
+		Name:                 data.Name.ValueString(),
+		CloudName:            data.Cloud.ValueString(),
+		ControllerCredential: true,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential, got error: %s", err))
+		return
+	}
+	// ReadCredential returns an empty credential when it is not found.
+	if response.CloudCredential.Label == "" {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Credential %q not found for cloud %q.", data.Name.ValueString(), data.Cloud.ValueString()))
+		return
+	}
+	d.trace(fmt.Sprintf("read credential data source %q", data.Name.ValueString()))
+
+	data.AuthType = types.StringValue(string(response.CloudCredential.AuthType()))
+	models, dErr := types.MapValueFrom(ctx, types.StringType, response.Models)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if response.Models == nil {
+		models = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	data.Models = models
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *credentialDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceCredential, msg, additionalFields...)
+}
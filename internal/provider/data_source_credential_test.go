@@ -0,0 +1,54 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceCredential(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+
+	dataSourceName := "data.juju_credential.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCredential(credentialName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", credentialName),
+					resource.TestCheckResourceAttr(dataSourceName, "cloud", "localhost"),
+					resource.TestCheckResourceAttr(dataSourceName, "auth_type", "certificate"),
+					resource.TestCheckResourceAttr(dataSourceName, "models.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCredential(credentialName string) string {
+	return fmt.Sprintf(`
+resource "juju_credential" "this" {
+  name = %q
+
+  cloud {
+    name = "localhost"
+  }
+
+  auth_type = "certificate"
+}
+
+data "juju_credential" "this" {
+  name  = juju_credential.this.name
+  cloud = "localhost"
+}`, credentialName)
+}
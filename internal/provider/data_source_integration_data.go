@@ -0,0 +1,211 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ datasource.DataSourceWithConfigure = &integrationDataDataSource{}
+
+// NewIntegrationDataDataSource returns an integration data data source.
+func NewIntegrationDataDataSource() datasource.DataSource {
+	return &integrationDataDataSource{}
+}
+
+type integrationDataDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type integrationDataDataSourceModel struct {
+	IntegrationID         types.String `tfsdk:"integration_id"`
+	IncludeUnitData       types.Bool   `tfsdk:"include_unit_data"`
+	ModelUUID             types.String `tfsdk:"model_uuid"`
+	ApplicationData       types.Map    `tfsdk:"application_data"`
+	ApplicationSecretData types.Map    `tfsdk:"application_secret_data"`
+	UnitData              types.Map    `tfsdk:"unit_data"`
+	UnitSecretData        types.Map    `tfsdk:"unit_secret_data"`
+
+	// ID required by the testing framework.
+	ID types.String `tfsdk:"id"`
+}
+
+// databagMapType is the type of the databag attributes: a map of databags
+// keyed by application or unit name.
+var databagMapType = types.MapType{ElemType: types.StringType}
+
+// Metadata implements the [datasource.DataSource] interface.
+func (d *integrationDataDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_data"
+}
+
+// Schema implements the [datasource.DataSource] interface.
+func (d *integrationDataDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the databags of a Juju integration. " +
+			"Databags are read through the units of the applications in the model, so the databags " +
+			"of remote applications and of applications without units are not included.",
+		Attributes: map[string]schema.Attribute{
+			"integration_id": schema.StringAttribute{
+				Description: "The ID of the integration, as exported by the juju_integration resource. " +
+					"Format: <model_uuid>:<provider_app_name>:<endpoint>:<requirer_app_name>:<endpoint>",
+				Required: true,
+			},
+			"include_unit_data": schema.BoolAttribute{
+				Description: "Whether to read the unit databags in addition to the application databags. Defaults to false.",
+				Optional:    true,
+			},
+			"model_uuid": schema.StringAttribute{
+				Description: "The UUID of the model where the integration exists.",
+				Computed:    true,
+			},
+			"application_data": schema.MapAttribute{
+				Description: "The application databags keyed by application name. Values which are secret URIs " +
+					"are omitted and reported in application_secret_data instead.",
+				ElementType: databagMapType,
+				Computed:    true,
+			},
+			"application_secret_data": schema.MapAttribute{
+				Description: "The values of the application databags which are secret URIs, keyed by application name.",
+				ElementType: databagMapType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"unit_data": schema.MapAttribute{
+				Description: "The unit databags keyed by unit name. Only set when include_unit_data is true. " +
+					"Values which are secret URIs are omitted and reported in unit_secret_data instead.",
+				ElementType: databagMapType,
+				Computed:    true,
+			},
+			"unit_secret_data": schema.MapAttribute{
+				Description: "The values of the unit databags which are secret URIs, keyed by unit name. " +
+					"Only set when include_unit_data is true.",
+				ElementType: databagMapType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The identifier of the integration data data source. Same as integration_id.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure implements the [datasource.DataSourceWithConfigure] interface.
+func (d *integrationDataDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderDataForDataSource(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = provider.Client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceIntegrationData)
+}
+
+// Read implements the [datasource.DataSource] interface.
+func (d *integrationDataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "integration data")
+		return
+	}
+
+	var data integrationDataDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelUUID, provider, requirer, diags := modelUUIDAndEndpointsFromID(data.IntegrationID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := juju.ReadIntegrationDataInput{
+		ModelUUID:    modelUUID,
+		Endpoints:    []string{provider, requirer},
+		IncludeUnits: data.IncludeUnitData.ValueBool(),
+	}
+	response, err := d.client.Integrations.ReadIntegrationData(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration data, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read integration data %q", data.IntegrationID.ValueString()), map[string]any{
+		"model_uuid":   modelUUID,
+		"applications": len(response.ApplicationData),
+		"units":        len(response.UnitData),
+	})
+
+	data.ModelUUID = types.StringValue(modelUUID)
+	data.ID = data.IntegrationID
+	data.ApplicationData, data.ApplicationSecretData, diags = databagMaps(ctx, response.ApplicationData)
+	resp.Diagnostics.Append(diags...)
+	if input.IncludeUnits {
+		data.UnitData, data.UnitSecretData, diags = databagMaps(ctx, response.UnitData)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		data.UnitData = types.MapNull(databagMapType)
+		data.UnitSecretData = types.MapNull(databagMapType)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// databagMaps splits the given databags into the values which are plain
+// data and the values which are secret URIs, so the latter can be
+// reported as sensitive.
+func databagMaps(ctx context.Context, databags map[string]map[string]string) (types.Map, types.Map, diag.Diagnostics) {
+	plain := make(map[string]map[string]string, len(databags))
+	secrets := make(map[string]map[string]string)
+	for owner, databag := range databags {
+		plain[owner] = make(map[string]string, len(databag))
+		for k, v := range databag {
+			if juju.IsSecretURI(v) {
+				if secrets[owner] == nil {
+					secrets[owner] = make(map[string]string)
+				}
+				secrets[owner][k] = v
+				continue
+			}
+			plain[owner][k] = v
+		}
+	}
+
+	var diags diag.Diagnostics
+	plainValue, dErr := types.MapValueFrom(ctx, databagMapType, plain)
+	diags.Append(dErr...)
+	secretsValue, dErr := types.MapValueFrom(ctx, databagMapType, secrets)
+	diags.Append(dErr...)
+	return plainValue, secretsValue, diags
+}
+
+func (d *integrationDataDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceIntegrationData, msg, additionalFields...)
+}
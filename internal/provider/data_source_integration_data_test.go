@@ -0,0 +1,91 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceIntegrationData(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	testAccPreCheck(t)
+
+	modelName := acctest.RandomWithPrefix("tf-datasource-integration-data-test-model")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIntegrationData(modelName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.juju_integration_data.this", "model_uuid", "juju_model.this", "uuid"),
+					resource.TestCheckResourceAttrPair("data.juju_integration_data.this", "id", "juju_integration.this", "id"),
+					resource.TestCheckResourceAttrSet("data.juju_integration_data.this", "application_data.%"),
+					resource.TestCheckNoResourceAttr("data.juju_integration_data.this", "unit_data.%"),
+				),
+			},
+			{
+				Config: testAccDataSourceIntegrationData(modelName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.juju_integration_data.this", "application_data.%"),
+					resource.TestCheckResourceAttrSet("data.juju_integration_data.this", "unit_data.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIntegrationData(modelName string, includeUnitData bool) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "one" {
+  model_uuid = juju_model.this.uuid
+  name       = "one"
+
+  charm {
+    name = "juju-qa-dummy-sink"
+    base = "ubuntu@22.04"
+  }
+}
+
+resource "juju_application" "two" {
+  model_uuid = juju_model.this.uuid
+  name       = "two"
+
+  charm {
+    name = "juju-qa-dummy-source"
+    base = "ubuntu@22.04"
+  }
+}
+
+resource "juju_integration" "this" {
+  model_uuid = juju_model.this.uuid
+
+  application {
+    name     = juju_application.one.name
+    endpoint = "source"
+  }
+
+  application {
+    name     = juju_application.two.name
+    endpoint = "sink"
+  }
+}
+
+data "juju_integration_data" "this" {
+  integration_id    = juju_integration.this.id
+  include_unit_data = %t
+}
+`, modelName, includeUnitData)
+}
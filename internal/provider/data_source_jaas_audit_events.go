@@ -0,0 +1,292 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &jaasAuditEventsDataSource{}
+
+// defaultAuditEventsLimit is the number of audit events returned when no
+// limit is set.
+const defaultAuditEventsLimit = 100
+
+type jaasAuditEventsDataSource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+// NewJAASAuditEventsDataSource returns a new JAAS audit events data source instance.
+func NewJAASAuditEventsDataSource() datasource.DataSource {
+	return &jaasAuditEventsDataSource{}
+}
+
+type jaasAuditEventsDataSourceModel struct {
+	After  types.String `tfsdk:"after"`
+	Before types.String `tfsdk:"before"`
+	User   types.String `tfsdk:"user"`
+	Model  types.String `tfsdk:"model"`
+	Method types.String `tfsdk:"method"`
+	Limit  types.Int64  `tfsdk:"limit"`
+	Events types.List   `tfsdk:"events"`
+}
+
+type jaasAuditEventModel struct {
+	Time           types.String `tfsdk:"time"`
+	ConversationID types.String `tfsdk:"conversation_id"`
+	MessageID      types.Int64  `tfsdk:"message_id"`
+	FacadeName     types.String `tfsdk:"facade_name"`
+	FacadeMethod   types.String `tfsdk:"facade_method"`
+	FacadeVersion  types.Int64  `tfsdk:"facade_version"`
+	ObjectID       types.String `tfsdk:"object_id"`
+	User           types.String `tfsdk:"user"`
+	Model          types.String `tfsdk:"model"`
+	IsResponse     types.Bool   `tfsdk:"is_response"`
+	Params         types.String `tfsdk:"params"`
+	Errors         types.String `tfsdk:"errors"`
+}
+
+var jaasAuditEventType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"time":            types.StringType,
+		"conversation_id": types.StringType,
+		"message_id":      types.Int64Type,
+		"facade_name":     types.StringType,
+		"facade_method":   types.StringType,
+		"facade_version":  types.Int64Type,
+		"object_id":       types.StringType,
+		"user":            types.StringType,
+		"model":           types.StringType,
+		"is_response":     types.BoolType,
+		"params":          types.StringType,
+		"errors":          types.StringType,
+	},
+}
+
+// Metadata returns the metadata for the JAAS audit events data source.
+func (d *jaasAuditEventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_audit_events"
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+func (d *jaasAuditEventsDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		NewResourceRequiresJAASValidator(d.client),
+	}
+}
+
+// Schema defines the schema for JAAS audit events.
+func (d *jaasAuditEventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	isRFC3339 := func(s string) bool {
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	}
+	resp.Schema = schema.Schema{
+		Description: "A data source that queries the JAAS audit log, returning the most recent events first." +
+			" Reading the audit log requires audit log access in JAAS.",
+		Attributes: map[string]schema.Attribute{
+			"after": schema.StringAttribute{
+				Description: "Only return events which happened after this time, in RFC3339 format.",
+				Optional:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(isRFC3339, "must be a time in RFC3339 format"),
+				},
+			},
+			"before": schema.StringAttribute{
+				Description: "Only return events which happened before this time, in RFC3339 format.",
+				Optional:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(isRFC3339, "must be a time in RFC3339 format"),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description: "Only return events performed by this user.",
+				Optional:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidUser, "must be a valid user name"),
+				},
+			},
+			"model": schema.StringAttribute{
+				Description: "Only return events performed against this model.",
+				Optional:    true,
+			},
+			"method": schema.StringAttribute{
+				Description: "Only return events calling this facade method, e.g. `AddModel`.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of events to return. Defaults to %d.", defaultAuditEventsLimit),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"events": schema.ListNestedAttribute{
+				Description: "The audit events, most recent first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"time": schema.StringAttribute{
+							Description: "The time of the event, in RFC3339 format.",
+							Computed:    true,
+						},
+						"conversation_id": schema.StringAttribute{
+							Description: "The unique ID of the connection the event happened in.",
+							Computed:    true,
+						},
+						"message_id": schema.Int64Attribute{
+							Description: "The ID correlating a request with its response.",
+							Computed:    true,
+						},
+						"facade_name": schema.StringAttribute{
+							Description: "The name of the facade called.",
+							Computed:    true,
+						},
+						"facade_method": schema.StringAttribute{
+							Description: "The facade method called.",
+							Computed:    true,
+						},
+						"facade_version": schema.Int64Attribute{
+							Description: "The version of the facade called.",
+							Computed:    true,
+						},
+						"object_id": schema.StringAttribute{
+							Description: "The ID of the object acted on, for the facades using it.",
+							Computed:    true,
+						},
+						"user": schema.StringAttribute{
+							Description: "The user who performed the call.",
+							Computed:    true,
+						},
+						"model": schema.StringAttribute{
+							Description: "The model the call was performed against.",
+							Computed:    true,
+						},
+						"is_response": schema.BoolAttribute{
+							Description: "Whether the event is the response to a call rather than the call itself.",
+							Computed:    true,
+						},
+						"params": schema.StringAttribute{
+							Description: "The JSON encoded parameters of the call, empty for responses.",
+							Computed:    true,
+						},
+						"errors": schema.StringAttribute{
+							Description: "The JSON encoded errors of the response, empty if the call succeeded.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the JAAS audit events data source with the provider data.
+func (d *jaasAuditEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderDataForDataSource(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = provider.Client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceJAASAuditEvents)
+}
+
+// Read queries the JAAS audit log.
+func (d *jaasAuditEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "jaas-audit-events")
+		return
+	}
+
+	var data jaasAuditEventsDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := juju.FindAuditEventsInput{
+		User:   data.User.ValueString(),
+		Model:  data.Model.ValueString(),
+		Method: data.Method.ValueString(),
+		Limit:  defaultAuditEventsLimit,
+	}
+	if !data.Limit.IsNull() {
+		input.Limit = int(data.Limit.ValueInt64())
+	}
+	// The times have been validated already.
+	if data.After.ValueString() != "" {
+		input.After, _ = time.Parse(time.RFC3339, data.After.ValueString())
+	}
+	if data.Before.ValueString() != "" {
+		input.Before, _ = time.Parse(time.RFC3339, data.Before.ValueString())
+	}
+
+	events, err := d.client.Jaas.FindAuditEvents(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read audit events, got error: %v", err))
+		return
+	}
+	d.trace("read audit events", map[string]interface{}{"count": len(events)})
+
+	eventModels := make([]jaasAuditEventModel, 0, len(events))
+	for _, event := range events {
+		eventModels = append(eventModels, jaasAuditEventModel{
+			Time:           types.StringValue(event.Time.Format(time.RFC3339)),
+			ConversationID: types.StringValue(event.ConversationID),
+			MessageID:      types.Int64Value(int64(event.MessageID)),
+			FacadeName:     types.StringValue(event.FacadeName),
+			FacadeMethod:   types.StringValue(event.FacadeMethod),
+			FacadeVersion:  types.Int64Value(int64(event.FacadeVersion)),
+			ObjectID:       types.StringValue(event.ObjectID),
+			User:           types.StringValue(event.User),
+			Model:          types.StringValue(event.Model),
+			IsResponse:     types.BoolValue(event.IsResponse),
+			Params:         types.StringValue(event.Params),
+			Errors:         types.StringValue(event.Errors),
+		})
+	}
+	eventsList, dErr := types.ListValueFrom(ctx, jaasAuditEventType, eventModels)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Events = eventsList
+
+	// Save the events to the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *jaasAuditEventsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceJAASAuditEvents, msg, additionalFields...)
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_DataSourceJAASAuditEvents(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	groupName := acctest.RandomWithPrefix("tf-jaas-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceJAASAuditEvents(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.juju_jaas_audit_events.test", "events.#"),
+					resource.TestCheckResourceAttr("data.juju_jaas_audit_events.test", "events.0.facade_method", "AddGroup"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DataSourceJAASAuditEvents_InvalidTime(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "juju_jaas_audit_events" "test" {
+  after = "yesterday"
+}`,
+				ExpectError: regexp.MustCompile("must be a time in RFC3339 format"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceJAASAuditEvents(groupName string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccDataSourceJAASAuditEvents",
+		`
+resource "juju_jaas_group" "test" {
+  name = "{{ .GroupName }}"
+}
+
+data "juju_jaas_audit_events" "test" {
+  method = "AddGroup"
+  limit  = 5
+
+  depends_on = [juju_jaas_group.test]
+}
+`, internaltesting.TemplateData{
+			"GroupName": groupName,
+		})
+}
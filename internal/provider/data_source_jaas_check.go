@@ -0,0 +1,157 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	jimmnames "github.com/canonical/jimm-go-sdk/v3/names"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &jaasCheckDataSource{}
+
+type jaasCheckDataSource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+// NewJAASCheckDataSource returns a new JAAS check data source instance.
+func NewJAASCheckDataSource() datasource.DataSource {
+	return &jaasCheckDataSource{}
+}
+
+type jaasCheckDataSourceModel struct {
+	Object   types.String `tfsdk:"object"`
+	Relation types.String `tfsdk:"relation"`
+	Target   types.String `tfsdk:"target"`
+	Allowed  types.Bool   `tfsdk:"allowed"`
+}
+
+// Metadata returns the metadata for the JAAS check data source.
+func (d *jaasCheckDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_check"
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+func (d *jaasCheckDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		NewResourceRequiresJAASValidator(d.client),
+	}
+}
+
+// Schema defines the schema for JAAS checks.
+func (d *jaasCheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source that checks whether a relation holds in JAAS, either because it was" +
+			" granted directly or because it is inherited through groups, roles or higher access levels." +
+			" Combined with a check block it verifies the effective permissions after an apply.",
+		Attributes: map[string]schema.Attribute{
+			"object": schema.StringAttribute{
+				Description: "The tag of the object the relation is checked for, e.g. `user-alice@canonical.com`" +
+					" or `group-<uuid>#member`.",
+				Required: true,
+				Validators: []validator.String{
+					ValidatorMatchString(isValidJAASTag, "must be a valid JAAS tag"),
+				},
+			},
+			"relation": schema.StringAttribute{
+				Description: "The relation to check, e.g. `reader`, `writer`, `administrator` or `member`.",
+				Required:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "The tag of the target of the relation, e.g. `model-<uuid>`, `group-<uuid>` or" +
+					" `controller-jimm` for JAAS itself.",
+				Required: true,
+				Validators: []validator.String{
+					ValidatorMatchString(isValidJAASTag, "must be a valid JAAS tag"),
+				},
+			},
+			"allowed": schema.BoolAttribute{
+				Description: "Whether the object has the relation to the target.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure sets up the JAAS check data source with the provider data.
+func (d *jaasCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderDataForDataSource(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = provider.Client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceJAASCheck)
+}
+
+// Read evaluates the relation against JAAS.
+func (d *jaasCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "jaas-check")
+		return
+	}
+
+	var data jaasCheckDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tuple := juju.JaasTuple{
+		Object:   data.Object.ValueString(),
+		Relation: data.Relation.ValueString(),
+		Target:   data.Target.ValueString(),
+	}
+	allowed, err := d.client.Jaas.CheckRelation(ctx, tuple)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check relation, got error: %v", err))
+		return
+	}
+	data.Allowed = types.BoolValue(allowed)
+	d.trace(fmt.Sprintf("checked relation %q of %q to %q: %t", tuple.Relation, tuple.Object, tuple.Target, allowed))
+
+	// Save the check result to the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *jaasCheckDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceJAASCheck, msg, additionalFields...)
+}
+
+// isValidJAASTag reports whether s is the tag of an object or target of
+// a JAAS relation.
+func isValidJAASTag(s string) bool {
+	// JAAS itself is addressed as the controller named jimm, which is
+	// not a valid controller UUID.
+	if s == names.NewControllerTag("jimm").String() {
+		return true
+	}
+	_, err := jimmnames.ParseTag(s)
+	return err == nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_DataSourceJAASCheck(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	groupName := acctest.RandomWithPrefix("tf-jaas-group")
+	member := "member@canonical.com"
+	nonMember := "non-member@canonical.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceJAASCheck(groupName, member, nonMember),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_jaas_check.member", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.juju_jaas_check.non_member", "allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DataSourceJAASCheck_InvalidTag(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "juju_jaas_check" "test" {
+  object   = "alice"
+  relation = "member"
+  target   = "controller-jimm"
+}`,
+				ExpectError: regexp.MustCompile("must be a valid JAAS tag"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceJAASCheck(groupName, member, nonMember string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccDataSourceJAASCheck",
+		`
+resource "juju_jaas_group" "test" {
+  name = "{{ .GroupName }}"
+}
+
+resource "juju_jaas_access_group" "test" {
+  group_id = juju_jaas_group.test.uuid
+  access   = "member"
+  users    = ["{{ .Member }}"]
+}
+
+data "juju_jaas_check" "member" {
+  object   = "user-{{ .Member }}"
+  relation = "member"
+  target   = "group-${juju_jaas_access_group.test.group_id}"
+}
+
+data "juju_jaas_check" "non_member" {
+  object   = "user-{{ .NonMember }}"
+  relation = "member"
+  target   = "group-${juju_jaas_access_group.test.group_id}"
+}
+`, internaltesting.TemplateData{
+			"GroupName": groupName,
+			"Member":    member,
+			"NonMember": nonMember,
+		})
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &jaasModelQueryDataSource{}
+
+type jaasModelQueryDataSource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+// NewJAASModelQueryDataSource returns a new JAAS cross-model query data source instance.
+func NewJAASModelQueryDataSource() datasource.DataSource {
+	return &jaasModelQueryDataSource{}
+}
+
+type jaasModelQueryDataSourceModel struct {
+	Query  types.String `tfsdk:"query"`
+	Models types.List   `tfsdk:"models"`
+}
+
+type jaasModelQueryResultModel struct {
+	ModelUUID types.String `tfsdk:"model_uuid"`
+	Results   types.List   `tfsdk:"results"`
+	Errors    types.List   `tfsdk:"errors"`
+}
+
+var jaasModelQueryResultType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"model_uuid": types.StringType,
+		"results":    types.ListType{ElemType: types.StringType},
+		"errors":     types.ListType{ElemType: types.StringType},
+	},
+}
+
+// Metadata returns the metadata for the JAAS model query data source.
+func (d *jaasModelQueryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_model_query"
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+func (d *jaasModelQueryDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		NewResourceRequiresJAASValidator(d.client),
+	}
+}
+
+// Schema defines the schema for JAAS cross-model queries.
+func (d *jaasModelQueryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source that runs a jq query against the status of every model the user can access in JAAS." +
+			" Models for which the status or the query failed are returned with their errors, and reported as warnings." +
+			" JAAS does not paginate the query, the outputs for all models are returned in a single response.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: "The jq expression evaluated against the status of each model, e.g. `.applications | keys`.",
+				Required:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(func(s string) bool { return strings.TrimSpace(s) != "" }, "must not be empty"),
+				},
+			},
+			"models": schema.ListNestedAttribute{
+				Description: "The outcome of the query for each model, sorted by model UUID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"model_uuid": schema.StringAttribute{
+							Description: "The UUID of the model.",
+							Computed:    true,
+						},
+						"results": schema.ListAttribute{
+							Description: "The JSON encoded outputs of the query for the model." +
+								" Queries iterating over the status, e.g. `.applications[]`, produce one output per iteration.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"errors": schema.ListAttribute{
+							Description: "The errors which occurred when querying the model.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the JAAS model query data source with the provider data.
+func (d *jaasModelQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderDataForDataSource(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = provider.Client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceJAASModelQuery)
+}
+
+// Read runs the query against the models in JAAS.
+func (d *jaasModelQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "jaas-model-query")
+		return
+	}
+
+	var data jaasModelQueryDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := d.client.Jaas.QueryModels(ctx, data.Query.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to query models, got error: %v", err))
+		return
+	}
+	d.trace("queried models", map[string]interface{}{"query": data.Query.ValueString(), "count": len(results)})
+
+	resultModels := make([]jaasModelQueryResultModel, 0, len(results))
+	for _, result := range results {
+		if len(result.Errors) > 0 {
+			resp.Diagnostics.AddWarning("Model Query Error",
+				fmt.Sprintf("Query failed for model %q: %s", result.ModelUUID, strings.Join(result.Errors, "; ")))
+		}
+		outputs, dErr := types.ListValueFrom(ctx, types.StringType, result.Results)
+		resp.Diagnostics.Append(dErr...)
+		errs, dErr := types.ListValueFrom(ctx, types.StringType, result.Errors)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resultModels = append(resultModels, jaasModelQueryResultModel{
+			ModelUUID: types.StringValue(result.ModelUUID),
+			Results:   outputs,
+			Errors:    errs,
+		})
+	}
+	models, dErr := types.ListValueFrom(ctx, jaasModelQueryResultType, resultModels)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Models = models
+
+	// Save the results to the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *jaasModelQueryDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceJAASModelQuery, msg, additionalFields...)
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_DataSourceJAASModelQuery(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	modelName := acctest.RandomWithPrefix("tf-jaas-query")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceJAASModelQuery(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_jaas_model_query.test", "models.*", map[string]string{
+						"results.#": "1",
+						"results.0": fmt.Sprintf("%q", modelName),
+						"errors.#":  "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceJAASModelQuery(modelName string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccDataSourceJAASModelQuery",
+		`
+resource "juju_model" "test" {
+  name = "{{ .ModelName }}"
+}
+
+data "juju_jaas_model_query" "test" {
+  query = ".model.name"
+
+  depends_on = [juju_model.test]
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+		})
+}
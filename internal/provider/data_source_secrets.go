@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -43,6 +44,8 @@ type secretDataSourceModel struct {
 	SecretId types.String `tfsdk:"secret_id"`
 	// SecretURI is the URI of the secret e.g. `secret:coj8mulh8b41e8nv6p90`.
 	SecretURI types.String `tfsdk:"secret_uri"`
+	// Revision of the secret to read, defaults to the current revision.
+	Revision types.Int64 `tfsdk:"revision"`
 }
 
 // Metadata returns the full data source name as used in terraform plans.
@@ -74,6 +77,15 @@ func (d *secretDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: "The URI of the secret. E.g. secret:coj8mulh8b41e8nv6p90",
 				Computed:    true,
 			},
+			"revision": schema.Int64Attribute{
+				Description: "The revision of the secret to read. Defaults to the current revision. " +
+					"Reading fails if the revision has been removed.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -123,6 +135,10 @@ func (d *secretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	} else {
 		readSecretInput.SecretId = data.SecretId.ValueString()
 	}
+	if !data.Revision.IsNull() && !data.Revision.IsUnknown() {
+		revision := int(data.Revision.ValueInt64())
+		readSecretInput.Revision = &revision
+	}
 
 	readSecretOutput, err := d.client.Secrets.ReadSecret(ctx, &readSecretInput)
 	if err != nil {
@@ -133,6 +149,9 @@ func (d *secretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	data.SecretId = types.StringValue(readSecretOutput.SecretId)
 	data.SecretURI = types.StringValue(readSecretOutput.SecretURI)
+	if data.Revision.IsNull() || data.Revision.IsUnknown() {
+		data.Revision = types.Int64Value(int64(readSecretOutput.CurrentRevision))
+	}
 
 	// Save state into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -41,6 +41,7 @@ func TestAcc_DataSourceSecret(t *testing.T) {
 					resource.TestCheckResourceAttr("data.juju_secret.secret_data_source", "name", secretName),
 					resource.TestCheckResourceAttrPair("data.juju_secret.secret_data_source", "secret_id", "juju_secret.secret_resource", "secret_id"),
 					resource.TestCheckResourceAttrPair("data.juju_secret.secret_data_source", "secret_uri", "juju_secret.secret_resource", "secret_uri"),
+					resource.TestCheckResourceAttr("data.juju_secret.secret_data_source", "revision", "1"),
 				),
 			},
 		},
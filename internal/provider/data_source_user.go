@@ -0,0 +1,139 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ datasource.DataSourceWithConfigure = &userDataSource{}
+
+// NewUserDataSource returns a user data source.
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for users.
+	subCtx context.Context
+}
+
+type userDataSourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	Access         types.String `tfsdk:"access"`
+	Disabled       types.Bool   `tfsdk:"disabled"`
+	LastConnection types.String `tfsdk:"last_connection"`
+	DateCreated    types.String `tfsdk:"date_created"`
+}
+
+// Metadata implements datasource.DataSourceWithConfigure.Metadata.
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema implements datasource.DataSourceWithConfigure.Schema.
+func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing a Juju User.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The username of the user.",
+				Required:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name of the user.",
+				Computed:    true,
+			},
+			"access": schema.StringAttribute{
+				Description: "The access level of the user on the controller.",
+				Computed:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Whether the user is disabled.",
+				Computed:    true,
+			},
+			"last_connection": schema.StringAttribute{
+				Description: "The time, in RFC3339 format, the user last connected to the controller." +
+					" Null if the user never connected.",
+				Computed: true,
+			},
+			"date_created": schema.StringAttribute{
+				Description: "The time, in RFC3339 format, the user was created.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.Configure.
+func (d *userDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderDataForDataSource(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = provider.Client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceUser)
+}
+
+// Read implements datasource.DataSourceWithConfigure.Read.
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "user")
+		return
+	}
+
+	var data userDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Users.ReadUser(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read user data source %q", data.Name.ValueString()))
+
+	info := response.UserInfo
+	data.Name = types.StringValue(info.Username)
+	data.DisplayName = types.StringValue(info.DisplayName)
+	data.Access = types.StringValue(info.Access)
+	data.Disabled = types.BoolValue(info.Disabled)
+	data.DateCreated = types.StringValue(info.DateCreated.Format(time.RFC3339))
+	if info.LastConnection != nil {
+		data.LastConnection = types.StringValue(info.LastConnection.Format(time.RFC3339))
+	} else {
+		data.LastConnection = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *userDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceUser, msg, additionalFields...)
+}
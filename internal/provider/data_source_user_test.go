@@ -0,0 +1,47 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceUser(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+
+	dataSourceName := "data.juju_user.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUser(userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", userName),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", "Terraform User"),
+					resource.TestCheckResourceAttr(dataSourceName, "disabled", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "date_created"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceUser(userName string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "this" {
+  name         = %q
+  display_name = "Terraform User"
+  password     = "S0m3P@ssw0rd"
+}
+
+data "juju_user" "this" {
+  name = juju_user.this.name
+}`, userName)
+}
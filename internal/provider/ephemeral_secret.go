@@ -0,0 +1,175 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResourceWithConfigure = &secretEphemeralResource{}
+
+// NewSecretEphemeralResource returns a secret ephemeral resource.
+func NewSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &secretEphemeralResource{}
+}
+
+type secretEphemeralResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// secretEphemeralResourceModel is the secret data revealed to terraform.
+// It is never persisted to the plan or the state.
+type secretEphemeralResourceModel struct {
+	// ModelUUID to which the secret belongs.
+	ModelUUID types.String `tfsdk:"model_uuid"`
+	// Name of the secret in the model.
+	Name types.String `tfsdk:"name"`
+	// SecretURI is the URI of the secret e.g. `secret:coj8mulh8b41e8nv6p90`.
+	SecretURI types.String `tfsdk:"secret_uri"`
+	// SecretId is the ID of the secret.
+	SecretId types.String `tfsdk:"secret_id"`
+	// Value is the revealed content of the secret.
+	Value types.Map `tfsdk:"value"`
+	// Info is the description of the secret.
+	Info types.String `tfsdk:"info"`
+}
+
+// Metadata returns the full ephemeral resource name as used in terraform
+// configurations.
+func (e *secretEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+// Schema returns the schema for the secret ephemeral resource.
+func (e *secretEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "An ephemeral resource revealing the content of a Juju Secret. The content is never " +
+			"stored in the plan or the state, so it can only be used in provider configurations and " +
+			"write-only attributes.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The uuid of the model containing the secret.",
+				Required:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the secret. Either name or secret_uri must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("secret_uri")),
+				},
+			},
+			"secret_uri": schema.StringAttribute{
+				Description: "The URI or the ID of the secret. E.g. secret:coj8mulh8b41e8nv6p90. " +
+					"Either name or secret_uri must be set.",
+				Optional: true,
+				Computed: true,
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "The ID of the secret. E.g. coj8mulh8b41e8nv6p90",
+				Computed:    true,
+			},
+			"value": schema.MapAttribute{
+				Description: "The content of the secret.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"info": schema.StringAttribute{
+				Description: "The description of the secret.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined EphemeralResource type.
+func (e *secretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderDataForEphemeralResource(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	e.client = provider.Client
+	e.subCtx = tflog.NewSubsystem(ctx, LogEphemeralResourceSecret)
+}
+
+// Open reveals the content of the secret.
+func (e *secretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Prevent panic if the provider has not been configured.
+	if e.client == nil {
+		addEphemeralClientNotConfiguredError(&resp.Diagnostics, "secret")
+		return
+	}
+
+	var data secretEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readSecretInput := juju.ReadSecretInput{
+		ModelUUID: data.ModelUUID.ValueString(),
+	}
+	if data.SecretURI.ValueString() == "" {
+		readSecretInput.Name = data.Name.ValueStringPointer()
+	} else {
+		readSecretInput.SecretId = data.SecretURI.ValueString()
+	}
+
+	readSecretOutput, err := e.client.Secrets.ReadSecret(ctx, &readSecretInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
+		return
+	}
+	e.trace(fmt.Sprintf("opened secret ephemeral resource %q", readSecretOutput.SecretURI))
+
+	value, dErr := types.MapValueFrom(ctx, types.StringType, readSecretOutput.Value)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SecretId = types.StringValue(readSecretOutput.SecretId)
+	data.SecretURI = types.StringValue(readSecretOutput.SecretURI)
+	data.Name = types.StringValue(readSecretOutput.Name)
+	data.Value = value
+	data.Info = types.StringValue(readSecretOutput.Info)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *secretEphemeralResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if e.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(e.subCtx, LogEphemeralResourceSecret, msg, additionalFields...)
+}
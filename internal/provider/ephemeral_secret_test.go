@@ -0,0 +1,104 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_EphemeralSecret(t *testing.T) {
+	skipTestIfSecretsNotSupported(t)
+
+	modelName := acctest.RandomWithPrefix("tf-ephemeral-secret-test-model")
+	// ...-test-[0-9]+ is not a valid secret name, need to remove the dash before numbers
+	secretName := fmt.Sprintf("tf-ephemeral-secret-test%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// The opened value is copied through a write-only attribute.
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralSecret(modelName, secretName, false),
+			},
+			{
+				Config: testAccEphemeralSecret(modelName, secretName, true),
+				// Ephemeral values never reach the state, the opened value
+				// is checked through the copy made from it.
+				Check: testAccCheckSecretValue("juju_secret.copy", map[string]string{"key1": "value1"}),
+			},
+		},
+	})
+}
+
+func testAccEphemeralSecret(modelName, secretName string, withEphemeral bool) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccEphemeralSecret",
+		`
+resource "juju_model" "this" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_secret" "this" {
+  model_uuid = juju_model.this.uuid
+  name       = "{{.SecretName}}"
+  value = {
+    key1 = "value1"
+  }
+}
+{{if .WithEphemeral}}
+ephemeral "juju_secret" "this" {
+  model_uuid = juju_model.this.uuid
+  name       = juju_secret.this.name
+}
+
+resource "juju_secret" "copy" {
+  model_uuid       = juju_model.this.uuid
+  name             = "{{.SecretName}}-copy"
+  value_wo         = ephemeral.juju_secret.this.value
+  value_wo_version = 1
+}
+{{end}}
+`, internaltesting.TemplateData{
+			"ModelName":     modelName,
+			"SecretName":    secretName,
+			"WithEphemeral": withEphemeral,
+		})
+}
+
+// testAccCheckSecretValue reads the content of a secret from Juju and
+// compares it with the expected value.
+func testAccCheckSecretValue(secretResource string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[secretResource]
+		if !ok {
+			return fmt.Errorf("not found: %s", secretResource)
+		}
+		secret, err := TestClient.Secrets.ReadSecret(context.Background(), &juju.ReadSecretInput{
+			SecretId:  rs.Primary.Attributes["secret_id"],
+			ModelUUID: rs.Primary.Attributes["model_uuid"],
+		})
+		if err != nil {
+			return fmt.Errorf("reading secret %s: %w", secretResource, err)
+		}
+		if !maps.Equal(secret.Value, expected) {
+			return fmt.Errorf("expected secret %s to hold %v, got %v", secretResource, expected, secret.Value)
+		}
+		return nil
+	}
+}
@@ -20,6 +20,8 @@ const (
 	LogDataSourceApplication = "datasource-application"
 	// LogDataSourceCharm is the logging subsystem for charm data sources.
 	LogDataSourceCharm = "datasource-charm"
+	// LogDataSourceIntegrationData is the logging subsystem for integration data data sources.
+	LogDataSourceIntegrationData = "datasource-integration-data"
 	// LogDataSourceMachine is the logging subsystem for machine data sources.
 	LogDataSourceMachine = "datasource-machine"
 	// LogDataSourceModel is the logging subsystem for model data sources.
@@ -36,9 +38,22 @@ const (
 	LogDataSourceSubnets = "datasource-subnets"
 	// LogDataSourceAction is the logging subsystem for action data sources.
 	LogDataSourceAction = "datasource-action"
+	// LogDataSourceCloud is the logging subsystem for cloud data sources.
+	LogDataSourceCloud = "datasource-cloud"
+	// LogDataSourceCredential is the logging subsystem for credential data sources.
+	LogDataSourceCredential = "datasource-credential"
+	// LogDataSourceUser is the logging subsystem for user data sources.
+	LogDataSourceUser = "datasource-user"
+
+	// LogEphemeralResourceSecret is the logging subsystem for secret ephemeral resources.
+	LogEphemeralResourceSecret = "ephemeral-secret"
 
 	// LogResourceApplication is the logging subsystem for application resources.
 	LogResourceApplication = "resource-application"
+	// LogResourceAccessCloud is the logging subsystem for access cloud resources.
+	LogResourceAccessCloud = "resource-access-cloud"
+	// LogResourceAccessController is the logging subsystem for access controller resources.
+	LogResourceAccessController = "resource-access-controller"
 	// LogResourceAccessModel is the logging subsystem for access model resources.
 	LogResourceAccessModel = "resource-access-model"
 	// LogResourceAccessOffer is the logging subsystem for access offer resources.
@@ -51,6 +66,8 @@ const (
 	LogResourceMachine = "resource-machine"
 	// LogResourceModel is the logging subsystem for model resources.
 	LogResourceModel = "resource-model"
+	// LogResourceModelAccessPolicy is the logging subsystem for model access policy resources.
+	LogResourceModelAccessPolicy = "resource-model-access-policy"
 	// LogResourceOffer is the logging subsystem for offer resources.
 	LogResourceOffer = "resource-offer"
 	// LogResourceSSHKey is the logging subsystem for SSH key resources.
@@ -76,6 +93,12 @@ const (
 	// LogResourceAction is the logging subsystem for action resources.
 	LogResourceAction = "resource-action"
 
+	// LogDataSourceJAASAuditEvents is the logging subsystem for JAAS audit events data sources.
+	LogDataSourceJAASAuditEvents = "datasource-jaas-audit-events"
+	// LogDataSourceJAASModelQuery is the logging subsystem for JAAS model query data sources.
+	LogDataSourceJAASModelQuery = "datasource-jaas-model-query"
+	// LogDataSourceJAASCheck is the logging subsystem for JAAS check data sources.
+	LogDataSourceJAASCheck = "datasource-jaas-check"
 	// LogDataSourceJAASGroup is the logging subsystem for JAAS group data sources.
 	LogDataSourceJAASGroup = "datasource-jaas-group"
 	// LogDataSourceJAASRole is the logging subsystem for JAAS role data sources.
@@ -93,10 +116,18 @@ const (
 	LogResourceJAASAccessOffer = "resource-jaas-access-offer"
 	// LogResourceJAASAccessController is the logging subsystem for JAAS access controller resources.
 	LogResourceJAASAccessController = "resource-jaas-access-controller"
+	// LogResourceJAASCloud is the logging subsystem for JAAS cloud resources.
+	LogResourceJAASCloud = "resource-jaas-cloud"
 	// LogResourceJAASController is the logging subsystem for JAAS controller resources.
 	LogResourceJAASController = "resource-jaas-controller"
 	// LogResourceJAASAccessSvcAcc is the logging subsystem for JAAS access service account resources.
 	LogResourceJAASAccessSvcAcc = "resource-jaas-access-service-account"
+	// LogResourceJAASServiceAccount is the logging subsystem for JAAS service account resources.
+	LogResourceJAASServiceAccount = "resource-jaas-service-account"
+	// LogResourceJAASServiceAccountCredential is the logging subsystem for JAAS service account credential resources.
+	LogResourceJAASServiceAccountCredential = "resource-jaas-service-account-credential"
+	// LogResourceJAASAccessPolicy is the logging subsystem for JAAS access policy resources.
+	LogResourceJAASAccessPolicy = "resource-jaas-access-policy"
 	// LogResourceJAASGroup is the logging subsystem for JAAS group resources.
 	LogResourceJAASGroup = "resource-jaas-group"
 	// LogResourceJAASRole is the logging subsystem for JAAS role resources.
@@ -122,6 +153,14 @@ func addDSClientNotConfiguredError(diag *diag.Diagnostics, dataSource string) {
 	)
 }
 
+func addEphemeralClientNotConfiguredError(diag *diag.Diagnostics, ephemeralResource string) {
+	diag.AddError(
+		"Provider Error, Client Not Configured",
+		fmt.Sprintf("Unable to open ephemeral resource %s. Expected configured Juju Client. "+
+			"Please report this issue to the provider developers.", ephemeralResource),
+	)
+}
+
 func intPtr(value types.Int64) *int {
 	count := int(value.ValueInt64())
 	return &count
@@ -209,6 +209,7 @@ func (r *applicationLister) getApplicationResource(
 			Resources:         types.MapNull(resourceType),
 			StorageDirectives: types.MapNull(types.StringType),
 			Storage:           types.SetNull(storageType),
+			GrantedSecrets:    types.SetNull(types.StringType),
 			ID:                types.StringNull(),
 		},
 		ModelUUID: types.StringNull(),
@@ -0,0 +1,172 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+type listCloudRequest struct {
+	Type types.String `tfsdk:"type"`
+}
+
+type cloudLister struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// NewCloudLister returns a new instance of the cloud lister.
+func NewCloudLister() list.ListResourceWithConfigure {
+	return &cloudLister{}
+}
+
+// Configure implements [list.ListResourceWithConfigure].
+func (r *cloudLister) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = provider.Client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceCloud)
+}
+
+// Metadata implements [list.ListResourceWithConfigure].
+func (r *cloudLister) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud"
+}
+
+// ListResourceConfigSchema implements [list.ListResourceWithConfigure].
+func (r *cloudLister) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Filter by cloud type.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// List implements [list.ListResourceWithConfigure].
+func (r *cloudLister) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var listRequest listCloudRequest
+
+	// Read list config data into the model.
+	diags := req.Config.Get(ctx, &listRequest)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cloudNames, err := r.client.Clouds.ListClouds(ctx)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(
+			diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Client Error",
+					fmt.Sprintf("Unable to list clouds, got error: %s", err),
+				),
+			},
+		)
+		return
+	}
+	sort.Strings(cloudNames)
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, cloudName := range cloudNames {
+			// Create result.
+			result := req.NewListResult(ctx)
+
+			cloud, err := r.client.Clouds.ReadCloud(ctx, juju.ReadCloudInput{Name: cloudName})
+			if err != nil {
+				result.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud %s, got error: %s", cloudName, err))
+				push(result)
+				return
+			}
+			if !listRequest.Type.IsNull() && !listRequest.Type.IsUnknown() && cloud.Type != listRequest.Type.ValueString() {
+				continue
+			}
+
+			// Set display name.
+			result.DisplayName = cloud.Name
+
+			// Set identity.
+			identity := cloudResourceIdentityModel{
+				ID: types.StringValue(cloud.Name),
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if req.IncludeResource {
+				cloudResource := cloudResourceModel{
+					ID:               identity.ID,
+					Name:             types.StringValue(cloud.Name),
+					Type:             types.StringValue(cloud.Type),
+					Endpoint:         types.StringNull(),
+					IdentityEndpoint: types.StringNull(),
+					StorageEndpoint:  types.StringNull(),
+					// The CA certificates are sensitive, they are not listed.
+					CACertificates: types.SetNull(types.StringType),
+				}
+				if cloud.Endpoint != "" {
+					cloudResource.Endpoint = types.StringValue(cloud.Endpoint)
+				}
+				if cloud.IdentityEndpoint != "" {
+					cloudResource.IdentityEndpoint = types.StringValue(cloud.IdentityEndpoint)
+				}
+				if cloud.StorageEndpoint != "" {
+					cloudResource.StorageEndpoint = types.StringValue(cloud.StorageEndpoint)
+				}
+				authTypes, dErr := types.SetValueFrom(ctx, types.StringType, cloud.AuthTypes)
+				result.Diagnostics.Append(dErr...)
+				cloudResource.AuthTypes = authTypes
+				cloudResource.Regions = flattenRegions(ctx, cloud.Regions, cloud.RegionConfig, &result.Diagnostics)
+				cloudResource.Config = types.MapNull(types.StringType)
+				if len(cloud.Config) > 0 {
+					cloudResource.Config = flattenCloudConfig(ctx, cloud.Config, &result.Diagnostics)
+				}
+				if result.Diagnostics.HasError() {
+					push(result)
+					return
+				}
+
+				result.Diagnostics.Append(result.Resource.Set(ctx, cloudResource)...)
+				if result.Diagnostics.HasError() {
+					push(result)
+					return
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
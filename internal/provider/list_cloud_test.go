@@ -0,0 +1,65 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccListClouds_query(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListClouds(),
+				Query:  true,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("juju_cloud.test", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact("localhost"),
+					}),
+					querycheck.ExpectResourceKnownValues(
+						"juju_cloud.test",
+						queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+							"id": knownvalue.StringExact("localhost"),
+						}),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("name"),
+								KnownValue: knownvalue.StringExact("localhost"),
+							},
+							{
+								Path:       tfjsonpath.New("type"),
+								KnownValue: knownvalue.StringExact("lxd"),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccListClouds() string {
+	return `
+list "juju_cloud" "test" {
+  provider         = juju
+  include_resource = true
+
+  config {
+    type = "lxd"
+  }
+}
+`
+}
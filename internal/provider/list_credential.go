@@ -0,0 +1,182 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// credentialSourceType is the type of the source block of the
+// credential resource.
+var credentialSourceType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":    types.StringType,
+		"path":    types.StringType,
+		"profile": types.StringType,
+		"version": types.Int64Type,
+	},
+}
+
+type listCredentialRequest struct {
+	Cloud types.String `tfsdk:"cloud"`
+}
+
+type credentialLister struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// NewCredentialLister returns a new instance of the credential lister.
+func NewCredentialLister() list.ListResourceWithConfigure {
+	return &credentialLister{}
+}
+
+// Configure implements [list.ListResourceWithConfigure].
+func (r *credentialLister) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = provider.Client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceCredential)
+}
+
+// Metadata implements [list.ListResourceWithConfigure].
+func (r *credentialLister) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+// ListResourceConfigSchema implements [list.ListResourceWithConfigure].
+func (r *credentialLister) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the controller credentials. The credential attributes are not listed, as they hold secrets.",
+		Attributes: map[string]listschema.Attribute{
+			"cloud": schema.StringAttribute{
+				Description: "Filter by cloud name.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// List implements [list.ListResourceWithConfigure].
+func (r *credentialLister) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var listRequest listCredentialRequest
+
+	// Read list config data into the model.
+	diags := req.Config.Get(ctx, &listRequest)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	credentials, err := r.client.Credentials.ListControllerCredentials(ctx)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(
+			diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Client Error",
+					fmt.Sprintf("Unable to list credentials, got error: %s", err),
+				),
+			},
+		)
+		return
+	}
+
+	// Sort the credentials by cloud, then by name.
+	type cloudCredentialName struct{ cloud, name string }
+	var found []cloudCredentialName
+	for cloudName, cloudCredential := range credentials.CloudCredentials {
+		if !listRequest.Cloud.IsNull() && !listRequest.Cloud.IsUnknown() && cloudName != listRequest.Cloud.ValueString() {
+			continue
+		}
+		for credentialName := range cloudCredential.AuthCredentials {
+			found = append(found, cloudCredentialName{cloud: cloudName, name: credentialName})
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].cloud != found[j].cloud {
+			return found[i].cloud < found[j].cloud
+		}
+		return found[i].name < found[j].name
+	})
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, f := range found {
+			credential := credentials.CloudCredentials[f.cloud].AuthCredentials[f.name]
+
+			// Create result.
+			result := req.NewListResult(ctx)
+
+			// Set display name.
+			result.DisplayName = fmt.Sprintf("%s/%s", f.cloud, f.name)
+
+			// Set identity, only controller credentials are listed.
+			identity := credentialResourceIdentityModel{
+				ID: types.StringValue(newCredentialIDFrom(f.name, f.cloud, false, true)),
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if req.IncludeResource {
+				cloud, errDiag := newCredentialCloudFromCloudName(ctx, f.cloud, result.Diagnostics)
+				result.Diagnostics.Append(errDiag...)
+				if result.Diagnostics.HasError() {
+					push(result)
+					return
+				}
+				credentialResource := credentialResourceModel{
+					ID:                   identity.ID,
+					Name:                 types.StringValue(f.name),
+					Cloud:                cloud,
+					AuthType:             types.StringValue(string(credential.AuthType())),
+					Attributes:           types.MapNull(types.StringType),
+					ClientCredential:     types.BoolValue(false),
+					ControllerCredential: types.BoolValue(true),
+					Validate:             types.BoolValue(false),
+					Force:                types.BoolValue(false),
+					Models:               types.MapNull(types.StringType),
+					Source:               types.ListNull(credentialSourceType),
+				}
+
+				result.Diagnostics.Append(result.Resource.Set(ctx, credentialResource)...)
+				if result.Diagnostics.HasError() {
+					push(result)
+					return
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+)
+
+func TestAccListCredentials_query(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCredential(credentialName, "certificate"),
+			},
+			{
+				Config: testAccListCredentials(),
+				Query:  true,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("juju_credential.test", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(newCredentialIDFrom(credentialName, "localhost", false, true)),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccListCredentials() string {
+	return `
+list "juju_credential" "test" {
+  provider         = juju
+  include_resource = true
+
+  config {
+    cloud = "localhost"
+  }
+}
+`
+}
@@ -142,7 +142,7 @@ func (r *integrationLister) List(ctx context.Context, req list.ListRequest, stre
 					return
 				}
 
-				resource, dErr := r.getIntegrationResource(ctx, resourceSchema, modelUUID, integration.Applications)
+				resource, dErr := r.getIntegrationResource(ctx, resourceSchema, modelUUID, integration)
 				if dErr.HasError() {
 					result.Diagnostics.Append(dErr...)
 					push(result)
@@ -168,21 +168,30 @@ func (r *integrationLister) getIntegrationResource(
 	ctx context.Context,
 	resourceSchema schema.Schema,
 	modelUUID string,
-	apps []juju.Application,
+	integration juju.ListIntegrationsOutput,
 ) (integrationResourceModelV1, diag.Diagnostics) {
 	applicationType := resourceSchema.GetBlocks()["application"].(schema.SetNestedBlock).NestedObject.Type()
 
 	resource := integrationResourceModelV1{
 		integrationResourceModel: integrationResourceModel{
-			Via:         types.StringNull(),
-			Application: types.SetNull(applicationType),
-			ID:          types.StringNull(),
+			Via:                   types.StringNull(),
+			Application:           types.SetNull(applicationType),
+			Suspended:             types.BoolValue(integration.Suspended),
+			SuspendedReason:       types.StringNull(),
+			RemoteSuspended:       types.BoolNull(),
+			RemoteSuspendedReason: types.StringNull(),
+			ConsumeGranted:        types.BoolValue(false),
+			ID:                    types.StringNull(),
 		},
 		ModelUUID: types.StringValue(modelUUID),
 	}
 
+	if integration.Suspended {
+		resource.SuspendedReason = types.StringValue(integration.SuspendedReason)
+	}
+
 	var diags diag.Diagnostics
-	applications, err := parseApplications(r.client, apps)
+	applications, err := parseApplications(r.client, integration.Applications)
 	if err != nil {
 		diags.AddError("Client Error", err.Error())
 		return integrationResourceModelV1{}, diags
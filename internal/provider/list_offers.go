@@ -157,6 +157,14 @@ func (r *offerLister) getOfferResource(ctx context.Context, offer juju.ListOffer
 		return offerResourceModelV2{}, diags
 	}
 	resource.Endpoints = endpointSet
+	resource.Description = types.StringValue(offer.Description)
+
+	connections, errDiag := offerConnectionsValue(ctx, offer.Connections)
+	diags.Append(errDiag...)
+	if diags.HasError() {
+		return offerResourceModelV2{}, diags
+	}
+	resource.Connections = connections
 
 	resource.AllowForceDestroy = types.BoolValue(false)
 	resource.Timeouts = timeouts.Value{
@@ -147,11 +147,15 @@ func (r *secretLister) List(ctx context.Context, req list.ListRequest, stream *l
 						ID:        resourceID,
 						Info:      types.StringValue(secret.Info),
 						Name:      types.StringValue(secret.Name),
+						AutoPrune: types.BoolValue(secret.AutoPrune),
 						// value_wo is write-only and never read back; keep it
 						// as a typed null map so framework type verification
 						// succeeds.
-						ValueWO:        types.MapNull(types.StringType),
-						ValueWOVersion: types.Int64Null(),
+						ValueWO:          types.MapNull(types.StringType),
+						ValueWOVersion:   types.Int64Null(),
+						ValueBase64:      types.MapNull(types.StringType),
+						ValueFiles:       types.MapNull(types.StringType),
+						ValueFilesSHA256: types.MapNull(types.StringType),
 					},
 				}
 
@@ -163,6 +167,12 @@ func (r *secretLister) List(ctx context.Context, req list.ListRequest, stream *l
 				}
 				resource.Value = secretValue
 
+				result.Diagnostics.Append(setSecretRevisions(ctx, &resource.secretResourceModel, secret.CurrentRevision, secret.Revisions)...)
+				if result.Diagnostics.HasError() {
+					push(result)
+					return
+				}
+
 				result.Diagnostics.Append(result.Resource.Set(ctx, resource)...)
 				if result.Diagnostics.HasError() {
 					push(result)
@@ -0,0 +1,149 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+type listUserRequest struct {
+	Disabled types.Bool `tfsdk:"disabled"`
+}
+
+type userLister struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// NewUserLister returns a new instance of the user lister.
+func NewUserLister() list.ListResourceWithConfigure {
+	return &userLister{}
+}
+
+// Configure implements [list.ListResourceWithConfigure].
+func (r *userLister) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = provider.Client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceUser)
+}
+
+// Metadata implements [list.ListResourceWithConfigure].
+func (r *userLister) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// ListResourceConfigSchema implements [list.ListResourceWithConfigure].
+func (r *userLister) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"disabled": schema.BoolAttribute{
+				Description: "Filter by whether the user is disabled.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// List implements [list.ListResourceWithConfigure].
+func (r *userLister) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var listRequest listUserRequest
+
+	// Read list config data into the model.
+	diags := req.Config.Get(ctx, &listRequest)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	users, err := r.client.Users.ListUsers(ctx)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(
+			diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Client Error",
+					fmt.Sprintf("Unable to list users, got error: %s", err),
+				),
+			},
+		)
+		return
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Username < users[j].Username })
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, user := range users {
+			if !listRequest.Disabled.IsNull() && !listRequest.Disabled.IsUnknown() && user.Disabled != listRequest.Disabled.ValueBool() {
+				continue
+			}
+
+			// Create result.
+			result := req.NewListResult(ctx)
+
+			// Set display name.
+			result.DisplayName = user.Username
+
+			// Set identity.
+			identity := userResourceIdentityModel{
+				ID: types.StringValue(newIDFromUserName(user.Username)),
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if req.IncludeResource {
+				userResource := userResourceModel{
+					ID:                 identity.ID,
+					Name:               types.StringValue(user.Username),
+					DisplayName:        types.StringNull(),
+					Password:           types.StringNull(),
+					PasswordWO:         types.StringNull(),
+					PasswordWOVersion:  types.Int64Null(),
+					Disabled:           types.BoolValue(user.Disabled),
+					RegistrationString: types.StringNull(),
+				}
+				if user.DisplayName != "" {
+					userResource.DisplayName = types.StringValue(user.DisplayName)
+				}
+				setUserTimestamps(&userResource, user)
+
+				result.Diagnostics.Append(result.Resource.Set(ctx, userResource)...)
+				if result.Diagnostics.HasError() {
+					push(result)
+					return
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
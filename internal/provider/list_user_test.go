@@ -0,0 +1,70 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccListUsers_query(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	expectedID := newIDFromUserName(userName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser(userName, userPassword),
+			},
+			{
+				Config: testAccListUsers(),
+				Query:  true,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("juju_user.test", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(expectedID),
+					}),
+					querycheck.ExpectResourceKnownValues(
+						"juju_user.test",
+						queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+							"id": knownvalue.StringExact(expectedID),
+						}),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("name"),
+								KnownValue: knownvalue.StringExact(userName),
+							},
+							{
+								Path:       tfjsonpath.New("disabled"),
+								KnownValue: knownvalue.Bool(false),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccListUsers() string {
+	return `
+list "juju_user" "test" {
+  provider         = juju
+  include_resource = true
+
+  config {
+    disabled = false
+  }
+}
+`
+}
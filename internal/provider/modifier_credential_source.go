@@ -0,0 +1,48 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AuthTypeFromSourceModifier marks an unconfigured auth_type as unknown
+// when the credential source changes, as the new source may hold a
+// credential of another auth type.
+func AuthTypeFromSourceModifier() planmodifier.String {
+	return authTypeFromSourceModifier{}
+}
+
+type authTypeFromSourceModifier struct{}
+
+// Description returns a description of the modifier.
+func (m authTypeFromSourceModifier) Description(_ context.Context) string {
+	return "Recomputes auth_type when the credential source changes"
+}
+
+// MarkdownDescription returns a markdown description of the modifier.
+func (m authTypeFromSourceModifier) MarkdownDescription(_ context.Context) string {
+	return "Recomputes `auth_type` when the credential `source` changes"
+}
+
+// PlanModifyString modifies the plan for a string attribute.
+func (m authTypeFromSourceModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var planSource, stateSource types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source"), &planSource)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("source"), &stateSource)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !planSource.Equal(stateSource) {
+		resp.PlanValue = types.StringUnknown()
+	}
+}
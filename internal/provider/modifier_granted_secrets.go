@@ -0,0 +1,92 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// GrantedSecretsModifier plans the secrets granted to an application from
+// the secret URIs found in its config, when auto_grant_secrets is enabled.
+func GrantedSecretsModifier() planmodifier.Set {
+	return grantedSecretsModifier{}
+}
+
+// grantedSecretsModifier implements the plan modifier.
+type grantedSecretsModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m grantedSecretsModifier) Description(_ context.Context) string {
+	return "Sets the granted secrets to the secret URIs found in the application config."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m grantedSecretsModifier) MarkdownDescription(_ context.Context) string {
+	return "Sets the granted secrets to the secret URIs found in the application config."
+}
+
+// PlanModifySet implements the plan modification logic.
+func (m grantedSecretsModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var autoGrant types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(AutoGrantSecretsKey), &autoGrant)...)
+	var config types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(ConfigKey), &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if autoGrant.IsUnknown() {
+		resp.PlanValue = types.SetUnknown(types.StringType)
+		return
+	}
+	if !autoGrant.ValueBool() {
+		resp.PlanValue = types.SetNull(types.StringType)
+		return
+	}
+	uris, known := secretURIsFromConfig(config)
+	if !known {
+		resp.PlanValue = types.SetUnknown(types.StringType)
+		return
+	}
+	planValue, diags := types.SetValueFrom(ctx, types.StringType, uris)
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = planValue
+}
+
+// secretURIsFromConfig returns the sorted secret URIs found in the values
+// of the application config. It returns false if the config, or one of
+// its values, is not known yet and may therefore be a secret URI.
+func secretURIsFromConfig(config types.Map) ([]string, bool) {
+	if config.IsUnknown() {
+		return nil, false
+	}
+	uris := []string{}
+	for _, value := range config.Elements() {
+		str, ok := value.(types.String)
+		if !ok {
+			continue
+		}
+		if str.IsUnknown() {
+			return nil, false
+		}
+		if juju.IsSecretURI(str.ValueString()) && !slices.Contains(uris, str.ValueString()) {
+			uris = append(uris, str.ValueString())
+		}
+	}
+	sort.Strings(uris)
+	return uris, true
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SuspendedReasonModifier plans an unconfigured suspended_reason from the
+// planned suspension: null when the integration is not suspended, the
+// prior reason when it stays suspended and empty when it gets suspended.
+func SuspendedReasonModifier() planmodifier.String {
+	return suspendedReasonModifier{}
+}
+
+type suspendedReasonModifier struct{}
+
+// Description returns a description of the modifier.
+func (m suspendedReasonModifier) Description(_ context.Context) string {
+	return "Plans suspended_reason from the planned suspension when it is not configured"
+}
+
+// MarkdownDescription returns a markdown description of the modifier.
+func (m suspendedReasonModifier) MarkdownDescription(_ context.Context) string {
+	return "Plans `suspended_reason` from the planned suspension when it is not configured"
+}
+
+// PlanModifyString modifies the plan for a string attribute.
+func (m suspendedReasonModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var planSuspended, stateSuspended types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("suspended"), &planSuspended)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("suspended"), &stateSuspended)...)
+	if resp.Diagnostics.HasError() || planSuspended.IsUnknown() {
+		return
+	}
+	switch {
+	case !planSuspended.ValueBool():
+		resp.PlanValue = types.StringNull()
+	case stateSuspended.ValueBool():
+		resp.PlanValue = req.StateValue
+	default:
+		resp.PlanValue = types.StringValue("")
+	}
+}
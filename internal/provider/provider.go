@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -128,6 +129,7 @@ func getEnvVar(field string) types.String {
 // Ensure jujuProvider satisfies various provider interfaces.
 var _ provider.ProviderWithListResources = &jujuProvider{}
 var _ provider.ProviderWithActions = &jujuProvider{}
+var _ provider.ProviderWithEphemeralResources = &jujuProvider{}
 
 // ProviderConfiguration contains optional provider setup hooks.
 type ProviderConfiguration struct {
@@ -432,6 +434,7 @@ func (p *jujuProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		resp.ResourceData = providerData
 		resp.DataSourceData = providerData
 		resp.ActionData = providerData
+		resp.EphemeralResourceData = providerData
 		return
 	}
 
@@ -526,6 +529,7 @@ func (p *jujuProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	resp.DataSourceData = providerData
 	resp.ListResourceData = providerData
 	resp.ActionData = providerData
+	resp.EphemeralResourceData = providerData
 }
 
 // getJujuProviderModel a filled in jujuProviderModel if able. First check
@@ -611,6 +615,8 @@ func getJujuProviderModel(ctx context.Context, planData jujuProviderModel, requi
 func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		func() resource.Resource { return NewControllerResource(p.newJujuCommand) },
+		func() resource.Resource { return NewAccessCloudResource() },
+		func() resource.Resource { return NewAccessControllerResource() },
 		func() resource.Resource { return NewAccessModelResource() },
 		func() resource.Resource { return NewAccessOfferResource() },
 		func() resource.Resource { return NewApplicationResource() },
@@ -619,6 +625,7 @@ func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource { return NewKubernetesCloudResource() },
 		func() resource.Resource { return NewMachineResource() },
 		func() resource.Resource { return NewModelResource() },
+		func() resource.Resource { return NewModelAccessPolicyResource() },
 		func() resource.Resource { return NewOfferResource() },
 		func() resource.Resource { return NewSSHKeyResource() },
 		func() resource.Resource { return NewUserResource() },
@@ -630,9 +637,13 @@ func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource { return NewJAASAccessRoleResource() },
 		func() resource.Resource { return NewJAASAccessOfferResource() },
 		func() resource.Resource { return NewJAASAccessControllerResource() },
+		func() resource.Resource { return NewJAASAccessPolicyResource() },
 		func() resource.Resource { return NewJAASGroupResource() },
 		func() resource.Resource { return NewJAASRoleResource() },
 		func() resource.Resource { return NewJAASControllerResource() },
+		func() resource.Resource { return NewJAASCloudResource() },
+		func() resource.Resource { return NewJAASServiceAccountResource() },
+		func() resource.Resource { return NewJAASServiceAccountCredentialResource() },
 		func() resource.Resource { return NewStoragePoolResource() },
 		func() resource.Resource { return NewSecretBackendResource() },
 		func() resource.Resource { return NewCloudResource() },
@@ -658,16 +669,35 @@ func (p *jujuProvider) DataSources(_ context.Context) []func() datasource.DataSo
 	return []func() datasource.DataSource{
 		func() datasource.DataSource { return NewApplicationDataSource() },
 		func() datasource.DataSource { return NewCharmDataSource() },
+		func() datasource.DataSource { return NewIntegrationDataDataSource() },
 		func() datasource.DataSource { return NewMachineDataSource() },
 		func() datasource.DataSource { return NewModelDataSource() },
 		func() datasource.DataSource { return NewOfferDataSource() },
 		func() datasource.DataSource { return NewSecretDataSource() },
+		func() datasource.DataSource { return NewJAASAuditEventsDataSource() },
+		func() datasource.DataSource { return NewJAASCheckDataSource() },
+		func() datasource.DataSource { return NewJAASModelQueryDataSource() },
 		func() datasource.DataSource { return NewJAASGroupDataSource() },
 		func() datasource.DataSource { return NewJAASRoleDataSource() },
 		func() datasource.DataSource { return NewSpaceDataSource() },
 		func() datasource.DataSource { return NewSubnetsDataSource() },
 		func() datasource.DataSource { return NewStoragePoolDataSource() },
 		func() datasource.DataSource { return NewActionDataSource() },
+		func() datasource.DataSource { return NewCloudDataSource() },
+		func() datasource.DataSource { return NewCredentialDataSource() },
+		func() datasource.DataSource { return NewUserDataSource() },
+	}
+}
+
+// EphemeralResources returns a slice of functions to instantiate each
+// EphemeralResource implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource
+// implementing the Metadata method. All ephemeral resources must have
+// unique names.
+func (p *jujuProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource { return NewSecretEphemeralResource() },
 	}
 }
 
@@ -715,6 +745,9 @@ func (p *jujuProvider) ListResources(_ context.Context) []func() list.ListResour
 		func() list.ListResource { return NewSpaceLister() },
 		func() list.ListResource { return NewStoragePoolLister() },
 		func() list.ListResource { return NewSecretLister() },
+		func() list.ListResource { return NewCloudLister() },
+		func() list.ListResource { return NewCredentialLister() },
+		func() list.ListResource { return NewUserLister() },
 	}
 }
 
@@ -769,6 +802,25 @@ func getProviderDataForDataSource(req datasource.ConfigureRequest, allowWithBoot
 	return provider, diags
 }
 
+// getProviderDataForEphemeralResource extracts and validates provider data from an ephemeral resource ConfigureRequest.
+// It performs type assertion and controller mode validation in one step.
+func getProviderDataForEphemeralResource(req ephemeral.ConfigureRequest, allowWithBootstrap bool) (juju.ProviderData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		diags.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return juju.ProviderData{}, diags
+	}
+	diags = checkControllerMode(diags, provider.Config, allowWithBootstrap)
+	if diags.HasError() {
+		return juju.ProviderData{}, diags
+	}
+	return provider, diags
+}
+
 // getProviderDataForAction extracts and validates provider data from an action ConfigureRequest.
 // It performs type assertion and controller mode validation in one step.
 func getProviderDataForAction(req action.ConfigureRequest, isTargetingControllers bool) (juju.ProviderData, diag.Diagnostics) {
@@ -0,0 +1,342 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &accessCloudResource{}
+var _ resource.ResourceWithConfigure = &accessCloudResource{}
+var _ resource.ResourceWithImportState = &accessCloudResource{}
+var _ resource.ResourceWithConfigValidators = &accessCloudResource{}
+
+// NewAccessCloudResource returns an access cloud resource.
+func NewAccessCloudResource() resource.Resource {
+	return &accessCloudResource{}
+}
+
+type accessCloudResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type accessCloudResourceModel struct {
+	CloudName types.String `tfsdk:"cloud_name"`
+	Access    types.String `tfsdk:"access"`
+	Users     types.Set    `tfsdk:"users"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata implements resource.ResourceWithConfigure interface.
+func (a *accessCloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_cloud"
+}
+
+// ConfigValidators implements resource.ResourceWithConfigValidators interface.
+func (a *accessCloudResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewAvoidJAASValidator(a.client, "juju_jaas_access_cloud"),
+	}
+}
+
+// Schema implements resource.ResourceWithConfigure interface.
+func (a *accessCloudResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents access of users to a Juju cloud. " +
+			"Removing a user from the set, or destroying the resource, revokes the access level: " +
+			"`admin` users are left with `add-model` access, `add-model` users lose access to the cloud.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_name": schema.StringAttribute{
+				Description: "The name of the cloud for access management. Changing this value will cause the" +
+					" access cloud to be destroyed and recreated by terraform.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidCloud, "must be a valid cloud name"),
+				},
+			},
+			"users": schema.SetAttribute{
+				Description: "Set of users to grant access to.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(ValidatorMatchString(names.IsValidUser, "must be a valid user name")),
+				},
+			},
+			"access": schema.StringAttribute{
+				Description: "Type of access to the cloud. Changing this value will cause the" +
+					" access cloud to be destroyed and recreated by terraform.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("add-model", "admin"),
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined Resource type.
+func (a *accessCloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderData(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.client = provider.Client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	a.subCtx = tflog.NewSubsystem(ctx, LogResourceAccessCloud)
+}
+
+// Create grants the access to every user in the set.
+func (a *accessCloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access cloud", "create")
+		return
+	}
+	var plan accessCloudResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudName := plan.CloudName.ValueString()
+	access := plan.Access.ValueString()
+	err := a.client.Clouds.GrantCloud(ctx, juju.GrantCloudInput{
+		Cloud:  cloudName,
+		Users:  users,
+		Access: access,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access cloud resource, got error: %s", err))
+		return
+	}
+	a.trace(fmt.Sprintf("granted %q access to cloud %q to %v", access, cloudName, users))
+
+	plan.ID = types.StringValue(newAccessCloudIDFrom(cloudName, access, users))
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the users which no longer have the access level from
+// the state, so that the next plan grants it again.
+func (a *accessCloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access cloud", "read")
+		return
+	}
+	var state accessCloudResourceModel
+
+	// Get the Terraform state from the request into the state
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudName, access, stateUsers, err := retrieveAccessCloudDataFromID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Malformed ID", err.Error())
+		return
+	}
+
+	userAccess, err := a.client.Clouds.CloudUserAccess(ctx, cloudName)
+	if errors.Is(err, juju.CloudNotFoundError) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access cloud resource, got error: %s", err))
+		return
+	}
+
+	users := []string{}
+	for _, user := range stateUsers {
+		// An admin can also add models, so granting it elsewhere does
+		// not count as drift for an add-model access resource.
+		if userAccess[user] == access || (access == "add-model" && userAccess[user] == "admin") {
+			users = append(users, user)
+		}
+	}
+
+	usersSet, dErr := types.SetValueFrom(ctx, types.StringType, users)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CloudName = types.StringValue(cloudName)
+	state.Access = types.StringValue(access)
+	state.Users = usersSet
+
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update grants the access to the added users and revokes it from the
+// removed ones. A change of cloud or access level replaces the resource.
+func (a *accessCloudResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access cloud", "update")
+		return
+	}
+
+	var plan, state accessCloudResourceModel
+
+	// Get the Terraform state from the request into the state
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planUsers, stateUsers []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &planUsers, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateUsers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudName := plan.CloudName.ValueString()
+	access := plan.Access.ValueString()
+	err := a.client.Clouds.RevokeCloud(ctx, juju.RevokeCloudInput{
+		Cloud:  cloudName,
+		Users:  getMissingUsers(stateUsers, planUsers),
+		Access: access,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access cloud resource, got error: %s", err))
+		return
+	}
+	err = a.client.Clouds.GrantCloud(ctx, juju.GrantCloudInput{
+		Cloud:  cloudName,
+		Users:  getAddedUsers(stateUsers, planUsers),
+		Access: access,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access cloud resource, got error: %s", err))
+		return
+	}
+	a.trace(fmt.Sprintf("updated %q access to cloud %q to %v", access, cloudName, planUsers))
+
+	plan.ID = types.StringValue(newAccessCloudIDFrom(cloudName, access, planUsers))
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete revokes the access level from every user in the set.
+func (a *accessCloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access cloud", "delete")
+		return
+	}
+
+	var state accessCloudResourceModel
+
+	// Get the Terraform state from the request into the state
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.Clouds.RevokeCloud(ctx, juju.RevokeCloudInput{
+		Cloud:  state.CloudName.ValueString(),
+		Users:  users,
+		Access: state.Access.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access cloud resource, got error: %s", err))
+	}
+}
+
+// ImportState imports an access cloud resource using the format
+// `<cloud>:<access>:<user1,user2>`.
+func (a *accessCloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, _, _, err := retrieveAccessCloudDataFromID(req.ID); err != nil {
+		resp.Diagnostics.AddError("ImportState Failure", err.Error())
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (a *accessCloudResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if a.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(a.subCtx, LogResourceAccessCloud, msg, additionalFields...)
+}
+
+func newAccessCloudIDFrom(cloudName, access string, users []string) string {
+	return fmt.Sprintf("%s:%s:%s", cloudName, access, strings.Join(users, ","))
+}
+
+func retrieveAccessCloudDataFromID(id string) (string, string, []string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", nil, fmt.Errorf("AccessCloud ID %q is malformed, "+
+			"please use the format '<cloud>:<access>:<user1,user2>'", id)
+	}
+	return parts[0], parts[1], strings.Split(parts[2], ","), nil
+}
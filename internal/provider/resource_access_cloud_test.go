@@ -0,0 +1,109 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_ResourceAccessCloud(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	userName2 := acctest.RandomWithPrefix("tfuser")
+	userPassword2 := acctest.RandomWithPrefix("tf-test-user")
+	cloudName := testingCloud.CloudName()
+
+	resourceName := "juju_access_cloud.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceAccessCloud(userName, userPassword, userName2, userPassword2, cloudName, "superuser", false),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match.*"),
+			},
+			{
+				Config: testAccResourceAccessCloud(userName, userPassword, userName2, userPassword2, cloudName, "add-model", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cloud_name", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "access", "add-model"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+				),
+			},
+			{
+				Config: testAccResourceAccessCloud(userName, userPassword, userName2, userPassword2, cloudName, "add-model", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName2),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceAccessCloud_ErrorWhenUsedWithJAAS(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "juju_access_cloud" "test" {
+  cloud_name = "localhost"
+  access     = "add-model"
+  users      = ["bob"]
+}`,
+				ExpectError: regexp.MustCompile("This resource is not supported with JAAS"),
+			},
+		},
+	})
+}
+
+func testAccResourceAccessCloud(userName, userPassword, userName2, userPassword2, cloudName, access string, bothUsers bool) string {
+	return internaltesting.GetStringFromTemplateWithData("testAccResourceAccessCloud", `
+resource "juju_user" "one" {
+  name     = "{{.UserName}}"
+  password = "{{.UserPassword}}"
+}
+
+resource "juju_user" "two" {
+  name     = "{{.UserName2}}"
+  password = "{{.UserPassword2}}"
+}
+
+resource "juju_access_cloud" "test" {
+  cloud_name = "{{.CloudName}}"
+  access     = "{{.Access}}"
+  {{- if .BothUsers }}
+  users      = [juju_user.one.name, juju_user.two.name]
+  {{- else }}
+  users      = [juju_user.one.name]
+  {{- end }}
+}
+`, internaltesting.TemplateData{
+		"UserName":      userName,
+		"UserPassword":  userPassword,
+		"UserName2":     userName2,
+		"UserPassword2": userPassword2,
+		"CloudName":     cloudName,
+		"Access":        access,
+		"BothUsers":     bothUsers,
+	})
+}
@@ -0,0 +1,339 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &accessControllerResource{}
+var _ resource.ResourceWithConfigure = &accessControllerResource{}
+var _ resource.ResourceWithImportState = &accessControllerResource{}
+var _ resource.ResourceWithConfigValidators = &accessControllerResource{}
+
+// NewAccessControllerResource returns an access controller resource.
+func NewAccessControllerResource() resource.Resource {
+	return &accessControllerResource{}
+}
+
+type accessControllerResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type accessControllerResourceModel struct {
+	Access types.String `tfsdk:"access"`
+	Users  types.Set    `tfsdk:"users"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata implements resource.ResourceWithConfigure interface.
+func (a *accessControllerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_controller"
+}
+
+// ConfigValidators implements resource.ResourceWithConfigValidators interface.
+func (a *accessControllerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewAvoidJAASValidator(a.client, "juju_jaas_access_controller"),
+	}
+}
+
+// Schema implements resource.ResourceWithConfigure interface.
+func (a *accessControllerResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents access of users to the Juju controller. " +
+			"Removing a user from the set, or destroying the resource, revokes the access level: " +
+			"`superuser` users are left with `login` access, `login` users can no longer log in. " +
+			"Users listed with `login` access which are superusers keep their access.",
+		Attributes: map[string]schema.Attribute{
+			"users": schema.SetAttribute{
+				Description: "Set of users to grant access to.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(ValidatorMatchString(names.IsValidUser, "must be a valid user name")),
+				},
+			},
+			"access": schema.StringAttribute{
+				Description: "Type of access to the controller. Changing this value will cause the" +
+					" access controller to be destroyed and recreated by terraform.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("login", "superuser"),
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined Resource type.
+func (a *accessControllerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderData(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.client = provider.Client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	a.subCtx = tflog.NewSubsystem(ctx, LogResourceAccessController)
+}
+
+// Create grants the access to every user in the set.
+func (a *accessControllerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "create")
+		return
+	}
+	var plan accessControllerResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access := plan.Access.ValueString()
+	for _, user := range users {
+		err := a.client.Users.GrantController(ctx, juju.GrantControllerInput{
+			User:   user,
+			Access: access,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access controller resource, got error: %s", err))
+			return
+		}
+	}
+	a.trace(fmt.Sprintf("granted %q controller access to %v", access, users))
+
+	plan.ID = types.StringValue(newAccessControllerIDFrom(access, users))
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the users which no longer have the access level from
+// the state, so that the next plan grants it again.
+func (a *accessControllerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "read")
+		return
+	}
+	var state accessControllerResourceModel
+
+	// Get the Terraform state from the request into the state
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access, stateUsers, err := retrieveAccessControllerDataFromID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Malformed ID", err.Error())
+		return
+	}
+
+	users := []string{}
+	for _, user := range stateUsers {
+		userAccess, err := a.client.Users.ControllerAccess(ctx, user)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access controller resource, got error: %s", err))
+			return
+		}
+		// A superuser also holds login access, so granting it elsewhere
+		// does not count as drift for a login access resource.
+		if userAccess == access || (access == "login" && userAccess == "superuser") {
+			users = append(users, user)
+		}
+	}
+
+	usersSet, dErr := types.SetValueFrom(ctx, types.StringType, users)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Access = types.StringValue(access)
+	state.Users = usersSet
+
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update grants the access to the added users and revokes it from the
+// removed ones. A change of access level replaces the resource.
+func (a *accessControllerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "update")
+		return
+	}
+
+	var plan, state accessControllerResourceModel
+
+	// Get the Terraform state from the request into the state
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planUsers, stateUsers []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &planUsers, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateUsers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access := plan.Access.ValueString()
+	for _, user := range getMissingUsers(stateUsers, planUsers) {
+		if err := a.revokeController(ctx, user, access); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access controller resource, got error: %s", err))
+			return
+		}
+	}
+	for _, user := range getAddedUsers(stateUsers, planUsers) {
+		err := a.client.Users.GrantController(ctx, juju.GrantControllerInput{
+			User:   user,
+			Access: access,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access controller resource, got error: %s", err))
+			return
+		}
+	}
+	a.trace(fmt.Sprintf("updated %q controller access to %v", access, planUsers))
+
+	plan.ID = types.StringValue(newAccessControllerIDFrom(access, planUsers))
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete revokes the access level from every user in the set.
+func (a *accessControllerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "delete")
+		return
+	}
+
+	var state accessControllerResourceModel
+
+	// Get the Terraform state from the request into the state
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, user := range users {
+		if err := a.revokeController(ctx, user, state.Access.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access controller resource, got error: %s", err))
+			return
+		}
+	}
+}
+
+// ImportState imports an access controller resource using the format
+// `<access>:<user1,user2>`.
+func (a *accessControllerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, _, err := retrieveAccessControllerDataFromID(req.ID); err != nil {
+		resp.Diagnostics.AddError("ImportState Failure", err.Error())
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// revokeController revokes the access level from the user. Revoking login
+// access from a superuser would remove all of its access, so a user
+// holding more access than the resource manages is left untouched.
+func (a *accessControllerResource) revokeController(ctx context.Context, user, access string) error {
+	if access == "login" {
+		current, err := a.client.Users.ControllerAccess(ctx, user)
+		if err != nil {
+			return err
+		}
+		if current == "superuser" {
+			a.trace(fmt.Sprintf("not revoking login controller access from superuser %q", user))
+			return nil
+		}
+	}
+	return a.client.Users.RevokeController(ctx, juju.RevokeControllerInput{
+		User:   user,
+		Access: access,
+	})
+}
+
+func (a *accessControllerResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if a.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(a.subCtx, LogResourceAccessController, msg, additionalFields...)
+}
+
+func newAccessControllerIDFrom(access string, users []string) string {
+	return fmt.Sprintf("%s:%s", access, strings.Join(users, ","))
+}
+
+func retrieveAccessControllerDataFromID(id string) (string, []string, error) {
+	access, users, ok := strings.Cut(id, ":")
+	if !ok || access == "" || users == "" {
+		return "", nil, fmt.Errorf("AccessController ID %q is malformed, "+
+			"please use the format '<access>:<user1,user2>'", id)
+	}
+	return access, strings.Split(users, ","), nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_ResourceAccessController(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	userName2 := acctest.RandomWithPrefix("tfuser")
+	userPassword2 := acctest.RandomWithPrefix("tf-test-user")
+
+	resourceName := "juju_access_controller.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceAccessController(userName, userPassword, userName2, userPassword2, "admin", false),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match.*"),
+			},
+			{
+				Config: testAccResourceAccessController(userName, userPassword, userName2, userPassword2, "superuser", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "superuser"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+				),
+			},
+			{
+				Config: testAccResourceAccessController(userName, userPassword, userName2, userPassword2, "superuser", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName2),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceAccessController_ErrorWhenUsedWithJAAS(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "juju_access_controller" "test" {
+  access = "login"
+  users  = ["bob"]
+}`,
+				ExpectError: regexp.MustCompile("This resource is not supported with JAAS"),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceAccessController_LoginKeepsSuperuser(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	userName2 := acctest.RandomWithPrefix("tfuser")
+	userPassword2 := acctest.RandomWithPrefix("tf-test-user")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessControllerLoginAndSuperuser(userName, userPassword, userName2, userPassword2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_access_controller.login", "users.#", "2"),
+				),
+			},
+			{
+				// Removing the superuser from the login resource must not
+				// revoke its access, which would show as drift on the
+				// superuser resource.
+				Config: testAccResourceAccessControllerLoginAndSuperuser(userName, userPassword, userName2, userPassword2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_access_controller.login", "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr("juju_access_controller.superuser", "users.*", userName),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceAccessController(userName, userPassword, userName2, userPassword2, access string, bothUsers bool) string {
+	return internaltesting.GetStringFromTemplateWithData("testAccResourceAccessController", `
+resource "juju_user" "one" {
+  name     = "{{.UserName}}"
+  password = "{{.UserPassword}}"
+}
+
+resource "juju_user" "two" {
+  name     = "{{.UserName2}}"
+  password = "{{.UserPassword2}}"
+}
+
+resource "juju_access_controller" "test" {
+  access = "{{.Access}}"
+  {{- if .BothUsers }}
+  users  = [juju_user.one.name, juju_user.two.name]
+  {{- else }}
+  users  = [juju_user.one.name]
+  {{- end }}
+}
+`, internaltesting.TemplateData{
+		"UserName":      userName,
+		"UserPassword":  userPassword,
+		"UserName2":     userName2,
+		"UserPassword2": userPassword2,
+		"Access":        access,
+		"BothUsers":     bothUsers,
+	})
+}
+
+func testAccResourceAccessControllerLoginAndSuperuser(userName, userPassword, userName2, userPassword2 string, superuserLogin bool) string {
+	return internaltesting.GetStringFromTemplateWithData("testAccResourceAccessControllerLoginAndSuperuser", `
+resource "juju_user" "one" {
+  name     = "{{.UserName}}"
+  password = "{{.UserPassword}}"
+}
+
+resource "juju_user" "two" {
+  name     = "{{.UserName2}}"
+  password = "{{.UserPassword2}}"
+}
+
+resource "juju_access_controller" "login" {
+  access = "login"
+  {{- if .SuperuserLogin }}
+  users  = [juju_user.one.name, juju_user.two.name]
+  {{- else }}
+  users  = [juju_user.two.name]
+  {{- end }}
+}
+
+# Juju refuses to grant login access to a superuser, so login is granted
+# first.
+resource "juju_access_controller" "superuser" {
+  access = "superuser"
+  users  = [juju_user.one.name]
+
+  depends_on = [juju_access_controller.login]
+}
+`, internaltesting.TemplateData{
+		"UserName":       userName,
+		"UserPassword":   userPassword,
+		"UserName2":      userName2,
+		"UserPassword2":  userPassword2,
+		"SuperuserLogin": superuserLogin,
+	})
+}
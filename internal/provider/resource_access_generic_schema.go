@@ -57,16 +57,7 @@ func baseAccessSchema() genericSchema {
 			// service accounts are treated as users but defined separately
 			// for different validation and logic in the provider.
 			Validators: []validator.Set{
-				setvalidator.ValueStringsAre(ValidatorMatchString(
-					func(s string) bool {
-						// Use EnsureValidServiceAccountId instead of IsValidServiceAccountId
-						// because we avoid requiring the user to add @serviceaccount for service accounts
-						// and opt to add that in the provide code. EnsureValidServiceAccountId adds the
-						// @serviceaccount domain before verifying the string is a valid service account ID.
-						_, err := jimmnames.EnsureValidServiceAccountId(s)
-						return err == nil
-					}, "service account ID must be a valid Juju username")),
-				setvalidator.ValueStringsAre(stringvalidator.RegexMatches(avoidAtSymbolRe, "service account should not contain an @ symbol")),
+				setvalidator.ValueStringsAre(serviceAccountIDValidators()...),
 			},
 		},
 		// ID required for imports
@@ -91,3 +82,20 @@ func (gS genericSchema) WithRoles() genericSchema {
 	}
 	return gS
 }
+
+// serviceAccountIDValidators returns the validators for a service account
+// ID, which is written without its @serviceaccount domain.
+func serviceAccountIDValidators() []validator.String {
+	return []validator.String{
+		ValidatorMatchString(
+			func(s string) bool {
+				// Use EnsureValidServiceAccountId instead of IsValidServiceAccountId
+				// because we avoid requiring the user to add @serviceaccount for service accounts
+				// and opt to add that in the provide code. EnsureValidServiceAccountId adds the
+				// @serviceaccount domain before verifying the string is a valid service account ID.
+				_, err := jimmnames.EnsureValidServiceAccountId(s)
+				return err == nil
+			}, "service account ID must be a valid Juju username"),
+		stringvalidator.RegexMatches(avoidAtSymbolRe, "service account should not contain an @ symbol"),
+	}
+}
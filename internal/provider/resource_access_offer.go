@@ -231,7 +231,7 @@ func (a *accessOfferResource) Read(ctx context.Context, req resource.ReadRequest
 
 		users[offerUserDetail.Access] = append(users[offerUserDetail.Access], offerUserDetail.UserName)
 	}
-	a.trace(fmt.Sprintf("read juju offer response %q", response))
+	a.trace(fmt.Sprintf("read juju offer response %v", response))
 
 	// If the user hasn't specified the access in the config, and the state is null, we should keep it null if there are no users.
 	// This prevents the "empty list -> null" change on every plan.
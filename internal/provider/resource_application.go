@@ -38,6 +38,9 @@ import (
 )
 
 const (
+	// AutoGrantSecretsKey is the schema key for granting access to the
+	// secrets referenced in the application config.
+	AutoGrantSecretsKey = "auto_grant_secrets"
 	// CharmKey is the schema key for charm configuration.
 	CharmKey = "charm"
 	// LocalCharmKey is the schema key for local charm configuration.
@@ -128,6 +131,8 @@ type applicationResourceModel struct {
 	Trust             types.Bool             `tfsdk:"trust"`
 	UnitCount         types.Int64            `tfsdk:"units"`
 	UnitNumbers       types.Set              `tfsdk:"unit_numbers"`
+	AutoGrantSecrets  types.Bool             `tfsdk:"auto_grant_secrets"`
+	GrantedSecrets    types.Set              `tfsdk:"granted_secrets"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -250,6 +255,20 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			AutoGrantSecretsKey: schema.BoolAttribute{
+				Description: "Grant the application access to the secrets whose URI is a value of `config`," +
+					" e.g. `secret:d1mhdjfmp25c77ccbm3g`, and revoke the access once a secret is no longer" +
+					" referenced. The secrets must belong to the application's model.",
+				Optional: true,
+			},
+			"granted_secrets": schema.SetAttribute{
+				Description: "The URIs of the secrets the application was granted access to by `auto_grant_secrets`.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					GrantedSecretsModifier(),
+				},
+			},
 			ConstraintsKey: schema.StringAttribute{
 				CustomType: CustomConstraintsType{},
 				Description: "Constraints imposed on this application. Changing this value will cause the" +
@@ -833,6 +852,14 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Config referencing secrets the application is granted access to
+	// is only set once access is granted, so that the charm can read
+	// the secrets from its first hooks.
+	var secretConfig map[string]string
+	if plan.AutoGrantSecrets.ValueBool() {
+		config, secretConfig = splitSecretConfig(config)
+	}
+
 	modelUUID := plan.ModelUUID.ValueString()
 	createResp, err := r.client.Applications.CreateApplication(ctx,
 		&juju.CreateApplicationInput{
@@ -859,6 +886,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	var partialApp juju.ApplicationPartiallyCreatedError
 	if errors.As(err, &partialApp) {
 		plan.ID = types.StringValue(newAppID(plan.ModelUUID.ValueString(), partialApp.AppName))
+		plan.GrantedSecrets = types.SetNull(types.StringType)
 		identity := applicationResourceIdentityModel{
 			ID: plan.ID,
 		}
@@ -873,6 +901,31 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	r.trace(fmt.Sprintf("create application resource %q", createResp.AppName))
+
+	if plan.AutoGrantSecrets.ValueBool() {
+		granted, _ := secretURIsFromConfig(plan.Config)
+		if err := r.updateSecretAccess(ctx, modelUUID, createResp.AppName, granted, juju.GrantAccess); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant application access to secrets, got error: %s", err))
+			return
+		}
+		var dErr diag.Diagnostics
+		plan.GrantedSecrets, dErr = types.SetValueFrom(ctx, types.StringType, granted)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(secretConfig) > 0 {
+			if err := r.client.Applications.UpdateApplication(ctx, &juju.UpdateApplicationInput{
+				ModelUUID: modelUUID,
+				AppName:   createResp.AppName,
+				Config:    secretConfig,
+			}); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set application config referencing secrets, got error: %s", err))
+				return
+			}
+		}
+	}
+
 	readResp, err := wait.WaitFor(
 		wait.WaitForCfg[*juju.ReadApplicationInput, *juju.ReadApplicationResponse]{
 			Context: ctx,
@@ -1273,6 +1326,14 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	if !state.GrantedSecrets.IsNull() {
+		state.GrantedSecrets, dErr = r.readGrantedSecrets(ctx, modelUUID, appName, state.GrantedSecrets)
+		if dErr.HasError() {
+			resp.Diagnostics.Append(dErr...)
+			return
+		}
+	}
+
 	r.trace("Found", applicationResourceModelForLogging(ctx, &state))
 	identity := applicationResourceIdentityModel{
 		ID: types.StringValue(newAppID(modelUUID, appName)),
@@ -1543,11 +1604,38 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		updateApplicationInput.StorageDirectives = directives
 	}
 
+	// Grant access to newly referenced secrets before the config
+	// referencing them is applied, and revoke access to the ones no
+	// longer referenced afterwards.
+	if plan.AutoGrantSecrets.ValueBool() {
+		granted, _ := secretURIsFromConfig(plan.Config)
+		var d diag.Diagnostics
+		plan.GrantedSecrets, d = types.SetValueFrom(ctx, types.StringType, granted)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	grants, revokes, d := grantedSecretsDelta(ctx, state.GrantedSecrets, plan.GrantedSecrets)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.updateSecretAccess(ctx, updateApplicationInput.ModelUUID, updateApplicationInput.AppName, grants, juju.GrantAccess); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant application access to secrets, got error: %s", err))
+		return
+	}
+
 	if err := r.client.Applications.UpdateApplication(ctx, &updateApplicationInput); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update application resource, got error: %s", err))
 		return
 	}
 
+	if err := r.updateSecretAccess(ctx, updateApplicationInput.ModelUUID, updateApplicationInput.AppName, revokes, juju.RevokeAccess); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke application access to secrets, got error: %s", err))
+		return
+	}
+
 	readResp, err := wait.WaitFor(
 		wait.WaitForCfg[*juju.ReadApplicationInput, *juju.ReadApplicationResponse]{
 			Context: ctx,
@@ -1664,6 +1752,105 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// updateSecretAccess grants or revokes the application access to each
+// of the given secrets.
+func (r *applicationResource) updateSecretAccess(ctx context.Context, modelUUID, appName string, secretURIs []string, action juju.AccessSecretAction) error {
+	for _, uri := range secretURIs {
+		err := r.client.Secrets.UpdateAccessSecret(ctx, &juju.GrantRevokeAccessSecretInput{
+			SecretId:     uri,
+			ModelUUID:    modelUUID,
+			Applications: []string{appName},
+		}, action)
+		if err != nil {
+			return fmt.Errorf("secret %q: %w", uri, err)
+		}
+		r.trace(fmt.Sprintf("updated access of application %q to secret %q", appName, uri))
+	}
+	return nil
+}
+
+// readGrantedSecrets returns the subset of the granted secrets the
+// application still has access to. Secrets which were removed, or
+// whose grant was revoked outside of terraform, are dropped so that
+// the next plan grants them again.
+func (r *applicationResource) readGrantedSecrets(ctx context.Context, modelUUID, appName string, granted types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var uris []string
+	diags.Append(granted.ElementsAs(ctx, &uris, false)...)
+	if diags.HasError() {
+		return granted, diags
+	}
+
+	stillGranted := make([]string, 0, len(uris))
+	for _, uri := range uris {
+		applications, err := r.client.Secrets.ReadSecretAccess(ctx, &juju.ReadSecretInput{
+			SecretId:  uri,
+			ModelUUID: modelUUID,
+		})
+		if errors.Is(err, juju.SecretNotFoundError) {
+			continue
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read secret %q, got error: %s", uri, err))
+			return granted, diags
+		}
+		if slices.Contains(applications, appName) {
+			stillGranted = append(stillGranted, uri)
+		}
+	}
+
+	result, dErr := types.SetValueFrom(ctx, types.StringType, stillGranted)
+	diags.Append(dErr...)
+	return result, diags
+}
+
+// splitSecretConfig splits the config of an application between the
+// entries whose value is a secret URI and the others.
+func splitSecretConfig(config map[string]string) (map[string]string, map[string]string) {
+	var secretConfig map[string]string
+	others := make(map[string]string, len(config))
+	for k, v := range config {
+		if !juju.IsSecretURI(v) {
+			others[k] = v
+			continue
+		}
+		if secretConfig == nil {
+			secretConfig = make(map[string]string)
+		}
+		secretConfig[k] = v
+	}
+	return others, secretConfig
+}
+
+// grantedSecretsDelta returns the secrets to grant and to revoke to move
+// from the state to the plan granted secrets.
+func grantedSecretsDelta(ctx context.Context, state, plan types.Set) ([]string, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var stateURIs, planURIs []string
+	if !state.IsNull() && !state.IsUnknown() {
+		diags.Append(state.ElementsAs(ctx, &stateURIs, false)...)
+	}
+	if !plan.IsNull() && !plan.IsUnknown() {
+		diags.Append(plan.ElementsAs(ctx, &planURIs, false)...)
+	}
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	var grants, revokes []string
+	for _, uri := range planURIs {
+		if !slices.Contains(stateURIs, uri) {
+			grants = append(grants, uri)
+		}
+	}
+	for _, uri := range stateURIs {
+		if !slices.Contains(planURIs, uri) {
+			revokes = append(revokes, uri)
+		}
+	}
+	return grants, revokes, diags
+}
+
 // updateStorage compares the plan storage directives to the
 // state storage directives, any new labels are returned to be
 // added as storage constraints.
@@ -3936,3 +3936,68 @@ resource "juju_application" "this" {
 }
 `, modelName, appName, charmName)
 }
+
+func TestAcc_ResourceApplication_AutoGrantSecrets(t *testing.T) {
+	skipTestIfSecretsNotSupported(t)
+	modelName := acctest.RandomWithPrefix("tf-test-application-grant")
+	appName := "test-app"
+	resourceName := "juju_application." + appName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationAutoGrantSecrets(modelName, appName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_grant_secrets", "true"),
+					resource.TestCheckResourceAttr(resourceName, "granted_secrets.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "granted_secrets.*", "juju_secret.this", "secret_uri"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationAutoGrantSecrets(modelName, appName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.token", "plain"),
+					resource.TestCheckResourceAttr(resourceName, "granted_secrets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationAutoGrantSecrets(modelName, appName string, useSecret bool) string {
+	return internaltesting.GetStringFromTemplateWithData("testAccResourceApplicationAutoGrantSecrets", `
+resource "juju_model" "this" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_secret" "this" {
+  model_uuid = juju_model.this.uuid
+  name       = "token"
+  value = {
+    token = "s3cr3t"
+  }
+}
+
+resource "juju_application" "{{.AppName}}" {
+  model_uuid = juju_model.this.uuid
+  name       = "{{.AppName}}"
+  charm {
+    name = "juju-qa-dummy-source"
+  }
+  config = {
+    {{- if .UseSecret }}
+    token = juju_secret.this.secret_uri
+    {{- else }}
+    token = "plain"
+    {{- end }}
+  }
+  auto_grant_secrets = true
+}
+`, internaltesting.TemplateData{
+		"ModelName": modelName,
+		"AppName":   appName,
+		"UseSecret": useSecret,
+	})
+}
@@ -4,8 +4,11 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	jujuerrors "github.com/juju/errors"
 	"github.com/stretchr/testify/require"
 
@@ -72,3 +75,55 @@ func TestAssertEqualsUnitCount(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretURIsFromConfig(t *testing.T) {
+	config := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"token":    types.StringValue("secret:d1mhdjfmp25c77ccbm3g"),
+		"password": types.StringValue("secret:coj8mulh8b41e8nv6p90"),
+		"again":    types.StringValue("secret:d1mhdjfmp25c77ccbm3g"),
+		"name":     types.StringValue("not-a-secret"),
+	})
+	uris, known := secretURIsFromConfig(config)
+	require.True(t, known)
+	require.Equal(t, []string{"secret:coj8mulh8b41e8nv6p90", "secret:d1mhdjfmp25c77ccbm3g"}, uris)
+
+	config = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"token": types.StringUnknown(),
+	})
+	_, known = secretURIsFromConfig(config)
+	require.False(t, known)
+}
+
+func TestSplitSecretConfig(t *testing.T) {
+	others, secretConfig := splitSecretConfig(map[string]string{
+		"token": "secret:d1mhdjfmp25c77ccbm3g",
+		"name":  "not-a-secret",
+	})
+	require.Equal(t, map[string]string{"name": "not-a-secret"}, others)
+	require.Equal(t, map[string]string{"token": "secret:d1mhdjfmp25c77ccbm3g"}, secretConfig)
+
+	others, secretConfig = splitSecretConfig(map[string]string{"name": "not-a-secret"})
+	require.Equal(t, map[string]string{"name": "not-a-secret"}, others)
+	require.Nil(t, secretConfig)
+}
+
+func TestGrantedSecretsDelta(t *testing.T) {
+	ctx := context.Background()
+	state := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("secret:a"),
+		types.StringValue("secret:b"),
+	})
+	plan := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("secret:b"),
+		types.StringValue("secret:c"),
+	})
+	grants, revokes, diags := grantedSecretsDelta(ctx, state, plan)
+	require.False(t, diags.HasError())
+	require.Equal(t, []string{"secret:c"}, grants)
+	require.Equal(t, []string{"secret:a"}, revokes)
+
+	grants, revokes, diags = grantedSecretsDelta(ctx, state, types.SetNull(types.StringType))
+	require.False(t, diags.HasError())
+	require.Empty(t, grants)
+	require.Equal(t, []string{"secret:a", "secret:b"}, revokes)
+}
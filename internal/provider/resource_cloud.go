@@ -6,11 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,6 +30,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &cloudResource{}
 var _ resource.ResourceWithConfigure = &cloudResource{}
+var _ resource.ResourceWithImportState = &cloudResource{}
+var _ resource.ResourceWithIdentity = &cloudResource{}
 
 // NewCloudResource returns a cloud resource.
 func NewCloudResource() resource.Resource {
@@ -45,6 +50,18 @@ type cloudRegionModel struct {
 	Endpoint         types.String `tfsdk:"endpoint"`
 	IdentityEndpoint types.String `tfsdk:"identity_endpoint"`
 	StorageEndpoint  types.String `tfsdk:"storage_endpoint"`
+	Config           types.Map    `tfsdk:"config"`
+}
+
+// cloudRegionType is the type of the elements of the regions attribute.
+var cloudRegionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":              types.StringType,
+		"endpoint":          types.StringType,
+		"identity_endpoint": types.StringType,
+		"storage_endpoint":  types.StringType,
+		"config":            types.MapType{ElemType: types.StringType},
+	},
 }
 
 type cloudResourceModel struct {
@@ -56,11 +73,16 @@ type cloudResourceModel struct {
 	StorageEndpoint  types.String `tfsdk:"storage_endpoint"`
 	CACertificates   types.Set    `tfsdk:"ca_certificates"`
 	Regions          types.List   `tfsdk:"regions"`
+	Config           types.Map    `tfsdk:"config"`
 
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
 
+type cloudResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 // Configure is used to configure the cloud resource.
 func (r *cloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -80,6 +102,22 @@ func (r *cloudResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.subCtx = tflog.NewSubsystem(ctx, LogResourceCloud)
 }
 
+// IdentitySchema implements [resource.ResourceWithIdentity].
+func (r *cloudResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ImportState imports a cloud by name.
+func (r *cloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
 // Metadata returns the metadata for the cloud resource.
 func (r *cloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cloud"
@@ -151,9 +189,10 @@ func (r *cloudResource) Schema(_ context.Context, req resource.SchemaRequest, re
 			// if no regions are specified. This is provided by the CLI client when adding clouds without regions.
 			// As such we are copying that behaviour here by providing a default region named "default" if no regions are specified.
 			"regions": schema.ListNestedAttribute{
-				Description: "List of regions for the cloud. The first region in the list is the default region for the cloud.",
-				Computed:    true,
-				Optional:    true,
+				Description: "List of regions for the cloud. The first region in the list is the default region for the cloud." +
+					" Regions can be added, edited and removed in place, but a region cannot be removed while a model uses it.",
+				Computed: true,
+				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{Required: true, Description: "Name of the region."},
@@ -172,10 +211,21 @@ func (r *cloudResource) Schema(_ context.Context, req resource.SchemaRequest, re
 							Description: "Region-specific storage endpoint.",
 							Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
 						},
+						"config": schema.MapAttribute{
+							Optional: true,
+							Description: "Region-specific configuration, used as defaults for the models in the region." +
+								" It overrides the cloud `config`.",
+							ElementType: types.StringType,
+						},
 					},
 				},
 				Default: defaultRegionForCloud{},
 			},
+			"config": schema.MapAttribute{
+				Description: "Cloud-wide configuration, used as defaults for the models in the cloud.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -200,7 +250,11 @@ func (r *cloudResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	regions := expandRegions(ctx, plan.Regions, &resp.Diagnostics)
+	regions, regionConfig := expandRegions(ctx, plan.Regions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config := expandCloudConfig(ctx, plan.Config, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -237,6 +291,8 @@ func (r *cloudResource) Create(ctx context.Context, req resource.CreateRequest,
 		StorageEndpoint:  plan.StorageEndpoint.ValueString(),
 		Regions:          regions,
 		CACertificates:   cacerts,
+		Config:           config,
+		RegionConfig:     regionConfig,
 		Force:            false,
 	}
 
@@ -249,6 +305,9 @@ func (r *cloudResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	plan.ID = types.StringValue(plan.Name.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	identity := cloudResourceIdentityModel{ID: plan.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 // Read reads the current state of the cloud.
@@ -265,7 +324,8 @@ func (r *cloudResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	out, err := r.client.Clouds.ReadCloud(ctx, juju.ReadCloudInput{Name: state.Name.ValueString()})
+	// The name is not known yet on import, the ID is the name.
+	out, err := r.client.Clouds.ReadCloud(ctx, juju.ReadCloudInput{Name: state.ID.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud, got error %s", err))
 		return
@@ -316,9 +376,11 @@ func (r *cloudResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Read regions from state to preserve ordering
 	var stateCrms []cloudRegionModel
-	resp.Diagnostics.Append(state.Regions.ElementsAs(ctx, &stateCrms, false)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !state.Regions.IsNull() {
+		resp.Diagnostics.Append(state.Regions.ElementsAs(ctx, &stateCrms, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// First, add regions that exist in state, in state order,
@@ -340,17 +402,28 @@ func (r *cloudResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 
-	lst := flattenRegions(ctx, orderedRegions, &resp.Diagnostics)
+	lst := flattenRegions(ctx, orderedRegions, out.RegionConfig, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	state.Regions = lst
 
+	// Keep a null config null rather than setting it to an empty map.
+	if len(out.Config) > 0 || !state.Config.IsNull() {
+		state.Config = flattenCloudConfig(ctx, out.Config, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	state.ID = types.StringValue(out.Name)
 
 	r.trace(fmt.Sprintf("Read cloud %s", state.Name.ValueString()))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	identity := cloudResourceIdentityModel{ID: state.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 // Update updates the cloud on the controller.
@@ -359,16 +432,60 @@ func (r *cloudResource) Update(ctx context.Context, req resource.UpdateRequest,
 		addClientNotConfiguredError(&resp.Diagnostics, "cloud", "update")
 		return
 	}
-	var plan cloudResourceModel
+	var plan, state cloudResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	regions := expandRegions(ctx, plan.Regions, &resp.Diagnostics)
+	regions, regionConfig := expandRegions(ctx, plan.Regions, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	config := expandCloudConfig(ctx, plan.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Refuse to remove regions still used by models, Juju would leave
+	// those models without a region.
+	stateRegions, _ := expandRegions(ctx, state.Regions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planRegionNames := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		planRegionNames[region.Name] = true
+	}
+	var removedRegions []string
+	for _, region := range stateRegions {
+		if !planRegionNames[region.Name] {
+			removedRegions = append(removedRegions, region.Name)
+		}
+	}
+	if len(removedRegions) > 0 {
+		regionModels, allModels, err := r.client.Clouds.CloudRegionModels(ctx, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list the models of cloud, got error %s", err))
+			return
+		}
+		if !allModels {
+			resp.Diagnostics.AddAttributeWarning(path.Root("regions"), "Region Usage Partially Checked",
+				"Only the models visible to the user were checked before removing regions, listing all models "+
+					"requires controller superuser access. Removing a region used by other models may fail.")
+		}
+		for _, region := range removedRegions {
+			if models := regionModels[region]; len(models) > 0 {
+				resp.Diagnostics.AddAttributeError(path.Root("regions"), "Region In Use",
+					fmt.Sprintf("Region %q of cloud %q cannot be removed, it is used by models: %s.",
+						region, plan.Name.ValueString(), strings.Join(models, ", ")))
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	authTypes := expandStringList(ctx, plan.AuthTypes, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -394,6 +511,8 @@ func (r *cloudResource) Update(ctx context.Context, req resource.UpdateRequest,
 		StorageEndpoint:  plan.StorageEndpoint.ValueString(),
 		Regions:          regions,
 		CACertificates:   cacerts,
+		Config:           config,
+		RegionConfig:     regionConfig,
 	}
 	if err := r.client.Clouds.UpdateCloud(ctx, input); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cloud, got error %s", err))
@@ -402,6 +521,9 @@ func (r *cloudResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	r.trace(fmt.Sprintf("Updated cloud %s", plan.Name.ValueString()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	identity := cloudResourceIdentityModel{ID: plan.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 // Delete removes the cloud from the controller.
@@ -438,12 +560,15 @@ func expandStringList(ctx context.Context, s types.Set, resp *diag.Diagnostics)
 	return result
 }
 
-func expandRegions(ctx context.Context, list types.List, resp *diag.Diagnostics) []jujucloud.Region {
+// expandRegions returns the regions of the cloud, and the configuration
+// of the regions which have some.
+func expandRegions(ctx context.Context, list types.List, resp *diag.Diagnostics) ([]jujucloud.Region, jujucloud.RegionConfig) {
 	var regModels []cloudRegionModel
 
 	resp.Append(list.ElementsAs(ctx, &regModels, false)...)
 
 	regions := make([]jujucloud.Region, 0, len(regModels))
+	var regionConfig jujucloud.RegionConfig
 	for _, rm := range regModels {
 		regions = append(regions, jujucloud.Region{
 			Name:             rm.Name.ValueString(),
@@ -451,14 +576,52 @@ func expandRegions(ctx context.Context, list types.List, resp *diag.Diagnostics)
 			IdentityEndpoint: rm.IdentityEndpoint.ValueString(),
 			StorageEndpoint:  rm.StorageEndpoint.ValueString(),
 		})
+		if config := expandCloudConfig(ctx, rm.Config, resp); len(config) > 0 {
+			if regionConfig == nil {
+				regionConfig = make(jujucloud.RegionConfig)
+			}
+			regionConfig[rm.Name.ValueString()] = config
+		}
+	}
+	return regions, regionConfig
+}
+
+// expandCloudConfig converts a cloud or region config map to the
+// attributes sent to Juju.
+func expandCloudConfig(ctx context.Context, m types.Map, resp *diag.Diagnostics) map[string]interface{} {
+	var values map[string]string
+	resp.Append(m.ElementsAs(ctx, &values, false)...)
+	if len(values) == 0 {
+		return nil
+	}
+
+	config := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		config[k] = v
 	}
-	return regions
+	return config
 }
 
-func flattenRegions(ctx context.Context, regions []jujucloud.Region, resp *diag.Diagnostics) types.List {
+// flattenCloudConfig converts cloud or region attributes read from Juju
+// to a config map.
+func flattenCloudConfig(ctx context.Context, config map[string]interface{}, resp *diag.Diagnostics) types.Map {
+	values := make(map[string]string, len(config))
+	for k, v := range config {
+		values[k] = fmt.Sprint(v)
+	}
+	m, diags := types.MapValueFrom(ctx, types.StringType, values)
+	resp.Append(diags...)
+	return m
+}
+
+func flattenRegions(ctx context.Context, regions []jujucloud.Region, regionConfig jujucloud.RegionConfig, resp *diag.Diagnostics) types.List {
 	items := make([]cloudRegionModel, 0, len(regions))
 
 	for _, r := range regions {
+		config := types.MapNull(types.StringType)
+		if len(regionConfig[r.Name]) > 0 {
+			config = flattenCloudConfig(ctx, regionConfig[r.Name], resp)
+		}
 		items = append(items, cloudRegionModel{
 			Name: types.StringValue(r.Name),
 			Endpoint: func() types.String {
@@ -479,17 +642,11 @@ func flattenRegions(ctx context.Context, regions []jujucloud.Region, resp *diag.
 				}
 				return types.StringValue(r.StorageEndpoint)
 			}(),
+			Config: config,
 		})
 	}
 
-	lst, diags := types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"name":              types.StringType,
-			"endpoint":          types.StringType,
-			"identity_endpoint": types.StringType,
-			"storage_endpoint":  types.StringType,
-		},
-	}, items)
+	lst, diags := types.ListValueFrom(ctx, cloudRegionType, items)
 
 	resp.Append(diags...)
 
@@ -519,14 +676,7 @@ type defaultRegionForCloud struct{}
 
 // DefaultSet implements [defaults.List.DefaultList] for a default cloud region.
 func (d defaultRegionForCloud) DefaultList(ctx context.Context, _ defaults.ListRequest, res *defaults.ListResponse) {
-	elemType := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"name":              types.StringType,
-			"endpoint":          types.StringType,
-			"identity_endpoint": types.StringType,
-			"storage_endpoint":  types.StringType,
-		},
-	}
+	elemType := cloudRegionType
 
 	obj, diags := types.ObjectValue(
 		elemType.AttrTypes,
@@ -535,6 +685,7 @@ func (d defaultRegionForCloud) DefaultList(ctx context.Context, _ defaults.ListR
 			"endpoint":          types.StringNull(),
 			"identity_endpoint": types.StringNull(),
 			"storage_endpoint":  types.StringNull(),
+			"config":            types.MapNull(types.StringType),
 		},
 	)
 	res.Diagnostics.Append(diags...)
@@ -20,6 +20,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/juju/errors"
 
@@ -207,6 +208,50 @@ func TestAcc_ResourceCloud_CACertsValidation(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceCloud_Config(t *testing.T) {
+	SkipJAAS(t)
+
+	cloudName := acctest.RandomWithPrefix("tf-test-cloud-config")
+	resourceName := "juju_cloud." + cloudName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckCloudDestroy(cloudName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCloud_Config(cloudName, "true", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config.use-floating-ip", "true"),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "regions.0.config.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "regions.0.config.use-default-secgroup", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "regions.1.config"),
+				),
+			},
+			// Edit cloud and region config in place.
+			{
+				Config: testAccResourceCloud_Config(cloudName, "false", "true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.use-floating-ip", "false"),
+					resource.TestCheckResourceAttr(resourceName, "regions.0.config.use-default-secgroup", "true"),
+				),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
 func testAccResourceCloud_OpenStack_Minimal(name string) string {
 	return `
 resource "juju_cloud" "` + name + `" {
@@ -217,6 +262,34 @@ resource "juju_cloud" "` + name + `" {
 `
 }
 
+func testAccResourceCloud_Config(name, floatingIP, defaultSecgroup string) string {
+	return fmt.Sprintf(`
+resource "juju_cloud" %[1]q {
+  name       = %[1]q
+  type       = "openstack"
+  auth_types = ["userpass"]
+
+  config = {
+    use-floating-ip = %[2]q
+  }
+
+  regions = [
+    {
+      name     = "default"
+      endpoint = "https://default.example.com"
+      config = {
+        use-default-secgroup = %[3]q
+      }
+    },
+    {
+      name     = "other"
+      endpoint = "https://other.example.com"
+    },
+  ]
+}
+`, name, floatingIP, defaultSecgroup)
+}
+
 // testAccCloudFromTemplate renders a juju_cloud resource using a named template and data.
 // Provide fields in data to control omission vs empty and list contents.
 // Supported keys in data:
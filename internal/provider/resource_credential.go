@@ -6,22 +6,27 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -30,6 +35,8 @@ import (
 var _ resource.Resource = &credentialResource{}
 var _ resource.ResourceWithConfigure = &credentialResource{}
 var _ resource.ResourceWithImportState = &credentialResource{}
+var _ resource.ResourceWithValidateConfig = &credentialResource{}
+var _ resource.ResourceWithIdentity = &credentialResource{}
 
 // NewCredentialResource returns a credential resource.
 func NewCredentialResource() resource.Resource {
@@ -43,6 +50,17 @@ type credentialResource struct {
 	subCtx context.Context
 }
 
+type credentialSourceModel struct {
+	Type    types.String `tfsdk:"type"`
+	Path    types.String `tfsdk:"path"`
+	Profile types.String `tfsdk:"profile"`
+	Version types.Int64  `tfsdk:"version"`
+}
+
+type credentialResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 type credentialResourceModel struct {
 	Cloud                types.List   `tfsdk:"cloud"`
 	Attributes           types.Map    `tfsdk:"attributes"`
@@ -50,6 +68,10 @@ type credentialResourceModel struct {
 	ClientCredential     types.Bool   `tfsdk:"client_credential"`
 	ControllerCredential types.Bool   `tfsdk:"controller_credential"`
 	Name                 types.String `tfsdk:"name"`
+	Validate             types.Bool   `tfsdk:"validate"`
+	Force                types.Bool   `tfsdk:"force"`
+	Models               types.Map    `tfsdk:"models"`
+	Source               types.List   `tfsdk:"source"`
 
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
@@ -82,6 +104,41 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"source": schema.ListNestedBlock{
+				Description: "A local cloud SDK file to read the credential from, like `juju autoload-credentials`." +
+					" The values read are sent to Juju but never stored in the Terraform state; `attributes`" +
+					" are merged over them. The file is read again when the block changes.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The format of the file: `aws` for an AWS shared credentials file," +
+								" `gce` for a GCE service account JSON key, `azure` for an Azure SDK auth file" +
+								" or `openstack` for an OpenStack clouds.yaml.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(juju.CredentialSourceTypes...),
+							},
+						},
+						"path": schema.StringAttribute{
+							Description: "The path of the file. A leading `~` is expanded to the home directory.",
+							Required:    true,
+						},
+						"profile": schema.StringAttribute{
+							Description: "The AWS profile or the OpenStack cloud to read. Defaults to `default`" +
+								" for AWS, and to the only cloud of an OpenStack clouds.yaml.",
+							Optional: true,
+						},
+						"version": schema.Int64Attribute{
+							Description: "Increment this value to read the file again and update the credential" +
+								" after its content changed.",
+							Optional: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
 		},
 		Attributes: map[string]schema.Attribute{
 			"attributes": schema.MapAttribute{
@@ -91,8 +148,13 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Sensitive:   true,
 			},
 			"auth_type": schema.StringAttribute{
-				Description: "Credential authorization type.",
-				Required:    true,
+				Description: "Credential authorization type. Required unless a `source` is set, which determines it.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					AuthTypeFromSourceModifier(),
+				},
 			},
 			"client_credential": schema.BoolAttribute{
 				Description: "Add credentials to the client.",
@@ -114,6 +176,29 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"validate": schema.BoolAttribute{
+				Description: "Check the new credential content against every model using the credential before" +
+					" updating the controller credential. The update is refused if the content is not valid for any model.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"force": schema.BoolAttribute{
+				Description: "Remove the controller credential even if models still use it. Those models" +
+					" are left without a valid credential.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"models": schema.MapAttribute{
+				Description: "The models using the controller credential, mapped to the access the" +
+					" current user has to them.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
 
 			// ID required by the testing framework
 			"id": schema.StringAttribute{
@@ -152,6 +237,13 @@ func (c *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	// auth_type
 	authType := data.AuthType.ValueString()
 
+	// source
+	authType, attributes = c.applyCredentialSource(ctx, data, authType, attributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AuthType = types.StringValue(authType)
+
 	// cloud.name
 	cloudName, errDiag := cloudNameFromCredentialCloud(ctx, data.Cloud.Elements()[0], resp.Diagnostics)
 	resp.Diagnostics.Append(errDiag...)
@@ -186,9 +278,14 @@ func (c *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	c.trace(fmt.Sprintf("created credential resource %q", credentialName))
 
 	data.ID = types.StringValue(newCredentialIDFrom(credentialName, response.CloudName, clientCredential, controllerCredential))
+	// A new credential is not used by any model yet.
+	data.Models = types.MapValueMust(types.StringType, map[string]attr.Value{})
 
 	// Write the state data into the Response.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := credentialResourceIdentityModel{ID: data.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -221,8 +318,12 @@ func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		ControllerCredential: controllerCredential,
 		Name:                 credentialName,
 	})
+	if errors.Is(err, errors.NotFound) {
+		c.trace(fmt.Sprintf("credential resource %q not found, removing it from state", credentialName))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		// TODO (cderici): call resp.State.RemoveResource() if NotFound
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential resource, got error: %s", err))
 		return
 	}
@@ -240,6 +341,25 @@ func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.ClientCredential = types.BoolValue(clientCredential)
 	data.ControllerCredential = types.BoolValue(controllerCredential)
 
+	// models
+	models, errDiag := types.MapValueFrom(ctx, types.StringType, response.Models)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if response.Models == nil {
+		models = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	data.Models = models
+
+	// validate & force are not stored remotely, default them on import
+	if data.Validate.IsNull() {
+		data.Validate = types.BoolValue(false)
+	}
+	if data.Force.IsNull() {
+		data.Force = types.BoolValue(false)
+	}
+
 	// retrieve name & auth_type
 	data.Name = types.StringValue(response.CloudCredential.Label)
 	data.AuthType = types.StringValue(string(response.CloudCredential.AuthType()))
@@ -270,6 +390,9 @@ func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Write the state data into the Response.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := credentialResourceIdentityModel{ID: data.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -299,7 +422,11 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	if data.AuthType.Equal(state.AuthType) &&
 		data.ClientCredential.Equal(state.ClientCredential) &&
 		data.ControllerCredential.Equal(state.ControllerCredential) &&
-		data.Attributes.Equal(state.Attributes) {
+		data.Attributes.Equal(state.Attributes) &&
+		data.Source.Equal(state.Source) {
+		// Only validate or force changed, which are not stored remotely.
+		data.Models = state.Models
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
@@ -310,8 +437,13 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// auth_type
-	newAuthType := data.AuthType.ValueString()
+	// auth_type, unset when it was computed from the source
+	var configAuthType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auth_type"), &configAuthType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newAuthType := configAuthType.ValueString()
 
 	// client_credential & controller_credential
 	newClientCredential := data.ClientCredential.ValueBool()
@@ -324,6 +456,28 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// source
+	newAuthType, newAttributes = c.applyCredentialSource(ctx, data, newAuthType, newAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AuthType = types.StringValue(newAuthType)
+
+	// Check the new content against the models using the credential
+	// before committing it.
+	if data.Validate.ValueBool() && newControllerCredential {
+		if err := c.client.Credentials.ValidateCredential(ctx, juju.ValidateCredentialInput{
+			Attributes: newAttributes,
+			AuthType:   newAuthType,
+			CloudName:  cloudName,
+			Name:       credentialName,
+		}); err != nil {
+			resp.Diagnostics.AddError("Credential Validation Error", fmt.Sprintf("Unable to update credential resource, got error: %s", err))
+			return
+		}
+		c.trace(fmt.Sprintf("validated credential resource %q", credentialName))
+	}
+
 	// Perform external call to modify resource
 	err := c.client.Credentials.UpdateCredential(ctx, juju.UpdateCredentialInput{
 		Attributes:           newAttributes,
@@ -340,9 +494,13 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	c.trace(fmt.Sprintf("updated credential resource %q", credentialName))
 
 	data.ID = types.StringValue(newCredentialIDFrom(credentialName, cloudName, newClientCredential, newControllerCredential))
+	data.Models = state.Models
 
 	// Write the updated state data into the Response.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := credentialResourceIdentityModel{ID: data.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 func (c *credentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -369,6 +527,39 @@ func (c *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Refuse to remove a controller credential still used by models,
+	// unless forced.
+	if controllerCredential && !data.Force.ValueBool() {
+		response, err := c.client.Credentials.ReadCredential(ctx, juju.ReadCredentialInput{
+			CloudName:            cloudName,
+			ControllerCredential: true,
+			Name:                 credentialName,
+		})
+		switch {
+		case errors.Is(err, errors.NotFound):
+			// Already removed from the controller, nothing to revoke.
+			c.trace(fmt.Sprintf("controller credential %q already removed", credentialName))
+			controllerCredential = false
+		case err != nil:
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete credential resource, got error: %s", err))
+			return
+		case len(response.Models) > 0:
+			models := make([]string, 0, len(response.Models))
+			for model := range response.Models {
+				models = append(models, model)
+			}
+			sort.Strings(models)
+			resp.Diagnostics.AddError("Credential In Use",
+				fmt.Sprintf("Unable to delete credential resource, credential %q is still used by model(s): %s."+
+					" Remove the models or set force to true.", credentialName, strings.Join(models, ", ")))
+			return
+		}
+	}
+	if !controllerCredential && !clientCredential {
+		c.trace(fmt.Sprintf("deleted credential resource %q", credentialName))
+		return
+	}
+
 	// Perform external call to destroy the resource
 	err := c.client.Credentials.DestroyCredential(
 		ctx,
@@ -377,9 +568,10 @@ func (c *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 			CloudName:            cloudName,
 			ControllerCredential: controllerCredential,
 			Name:                 credentialName,
+			Force:                data.Force.ValueBool(),
 		},
 	)
-	if err != nil {
+	if err != nil && !errors.Is(err, errors.NotFound) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete credential resource, got error: %s", err))
 	}
 	c.trace(fmt.Sprintf("deleted credential resource %q", credentialName))
@@ -401,8 +593,74 @@ func (c *credentialResource) Configure(ctx context.Context, req resource.Configu
 	c.subCtx = tflog.NewSubsystem(ctx, LogResourceCredential)
 }
 
+// ValidateConfig ensures the auth type is known, either configured or
+// read from a source.
+func (c *credentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data credentialResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AuthType.IsNull() && data.Source.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_type"),
+			"Missing Attribute Configuration",
+			"auth_type must be set when no source block is configured.",
+		)
+	}
+}
+
+// applyCredentialSource reads the source of the credential, if any, and
+// returns the auth type and attributes to send to Juju. Configured
+// attributes take precedence over the ones read from the source.
+func (c *credentialResource) applyCredentialSource(ctx context.Context, data credentialResourceModel, authType string, attributes map[string]string, diags *diag.Diagnostics) (string, map[string]string) {
+	if data.Source.IsNull() || len(data.Source.Elements()) == 0 {
+		return authType, attributes
+	}
+	var sources []credentialSourceModel
+	diags.Append(data.Source.ElementsAs(ctx, &sources, false)...)
+	if diags.HasError() {
+		return "", nil
+	}
+	source := sources[0]
+
+	output, err := juju.ReadCredentialSource(juju.CredentialSourceInput{
+		Type:    source.Type.ValueString(),
+		Path:    source.Path.ValueString(),
+		Profile: source.Profile.ValueString(),
+	})
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "Credential Source Error", fmt.Sprintf("Unable to read credential source, got error: %s", err))
+		return "", nil
+	}
+	if authType != "" && authType != output.AuthType {
+		diags.AddAttributeError(path.Root("auth_type"), "Credential Source Error",
+			fmt.Sprintf("auth_type %q does not match the auth type %q read from the %s source.", authType, output.AuthType, source.Type.ValueString()))
+		return "", nil
+	}
+	c.trace("read credential source", map[string]interface{}{"type": source.Type.ValueString(), "path": source.Path.ValueString()})
+
+	merged := output.Attributes
+	for k, v := range attributes {
+		merged[k] = v
+	}
+	return output.AuthType, merged
+}
+
+// IdentitySchema implements [resource.ResourceWithIdentity].
+func (c *credentialResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (c credentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (c *credentialResource) trace(msg string, additionalFields ...map[string]interface{}) {
@@ -5,10 +5,17 @@ package provider
 
 import (
 	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/juju/juju/api/jujuclient"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
 )
 
 func TestAcc_ResourceCredential(t *testing.T) {
@@ -29,6 +36,9 @@ func TestAcc_ResourceCredential(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", credentialName),
 					resource.TestCheckResourceAttr(resourceName, "auth_type", authType),
+					resource.TestCheckResourceAttr(resourceName, "validate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "force", "false"),
+					resource.TestCheckResourceAttr(resourceName, "models.%", "0"),
 				),
 			},
 			{
@@ -37,6 +47,7 @@ func TestAcc_ResourceCredential(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", credentialName),
 					resource.TestCheckResourceAttr(resourceName, "auth_type", authType),
 					resource.TestCheckResourceAttr(resourceName, "attributes.token", token),
+					resource.TestCheckResourceAttr(resourceName, "models.%", "0"),
 				),
 			},
 			{
@@ -53,6 +64,95 @@ func TestAcc_ResourceCredential(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceCredential_Source(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+	sourcePath := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(sourcePath, []byte("[default]\naws_access_key_id = AKIA\naws_secret_access_key = secret\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceCredentialSource(credentialName, "", ""),
+				ExpectError: regexp.MustCompile(`auth_type must be set when no source block is configured`),
+			},
+			{
+				Config:      testAccResourceCredentialSource(credentialName, "certificate", sourcePath),
+				ExpectError: regexp.MustCompile(`does not match the auth type "access-key" read from the aws source`),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceCredential_ValidateAndForce(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	// A model can only be added with a working credential, reuse the one
+	// of the client store.
+	clientCreds, err := jujuclient.NewFileClientStore().CredentialForCloud("localhost")
+	if err != nil {
+		t.Skipf("cannot read the client credentials for localhost: %v", err)
+	}
+	var authType string
+	var attributes map[string]string
+	for _, cred := range clientCreds.AuthCredentials {
+		authType = string(cred.AuthType())
+		attributes = cred.Attributes()
+		break
+	}
+	if authType != "certificate" {
+		t.Skip(t.Name() + " needs a certificate credential for localhost in the client store")
+	}
+	invalidAttributes := maps.Clone(attributes)
+	invalidAttributes["client-key"] = "not-a-key"
+
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+	modelName := acctest.RandomWithPrefix("tf-test-credential-model")
+	resourceName := "juju_credential.test-credential"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCredentialInUse(credentialName, modelName, authType, attributes, false, false, true),
+			},
+			{
+				// models is read back once the model uses the credential.
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "models.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "models."+modelName),
+				),
+			},
+			{
+				Config:      testAccResourceCredentialInUse(credentialName, modelName, authType, invalidAttributes, true, false, true),
+				ExpectError: regexp.MustCompile(`(?s)Credential Validation Error.*is not valid`),
+			},
+			{
+				// The model keeps using the credential removed from the
+				// configuration.
+				Config:      testAccResourceCredentialInUse(credentialName, modelName, authType, attributes, false, false, false),
+				ExpectError: regexp.MustCompile(`(?s)Credential In Use.*` + modelName),
+			},
+			{
+				Config: testAccResourceCredentialInUse(credentialName, modelName, authType, attributes, false, true, true),
+				Check:  resource.TestCheckResourceAttr(resourceName, "force", "true"),
+			},
+			{
+				Config: testAccResourceCredentialInUse(credentialName, modelName, authType, attributes, false, true, false),
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceCredential_UpgradeProvider(t *testing.T) {
 	// This skip is temporary until we have a stable version of the provider that supports
 	// Juju 4.0.0 and above, at which point we can re-enable it.
@@ -119,3 +219,73 @@ resource "juju_credential" "test-credential" {
   }
 }`, credentialName, authType, token)
 }
+
+func testAccResourceCredentialInUse(credentialName, modelName, authType string, attributes map[string]string, validate, force, withCredential bool) string {
+	return internaltesting.GetStringFromTemplateWithData("testAccResourceCredentialInUse", `
+{{- if .WithCredential}}
+resource "juju_credential" "test-credential" {
+  name = "{{.Name}}"
+
+  cloud {
+   name   = "localhost"
+  }
+
+  auth_type = "{{.AuthType}}"
+  validate  = {{.Validate}}
+  force     = {{.Force}}
+
+  attributes = {
+{{- range $key, $value := .Attributes}}
+    "{{$key}}" = {{printf "%q" $value}}
+{{- end}}
+  }
+}
+{{- end}}
+
+resource "juju_model" "test-model" {
+  name       = "{{.ModelName}}"
+  credential = "{{.Name}}"
+
+  cloud {
+    name = "localhost"
+  }
+{{- if .WithCredential}}
+
+  depends_on = [juju_credential.test-credential]
+{{- end}}
+}`, internaltesting.TemplateData{
+		"Name":           credentialName,
+		"ModelName":      modelName,
+		"AuthType":       authType,
+		"Attributes":     attributes,
+		"Validate":       validate,
+		"Force":          force,
+		"WithCredential": withCredential,
+	})
+}
+
+func testAccResourceCredentialSource(credentialName, authType, sourcePath string) string {
+	return internaltesting.GetStringFromTemplateWithData("testAccResourceCredentialSource", `
+resource "juju_credential" "test-credential" {
+  name = "{{.Name}}"
+
+  cloud {
+   name   = "localhost"
+  }
+{{- if .AuthType}}
+
+  auth_type = "{{.AuthType}}"
+{{- end}}
+{{- if .SourcePath}}
+
+  source {
+    type = "aws"
+    path = "{{.SourcePath}}"
+  }
+{{- end}}
+}`, internaltesting.TemplateData{
+		"Name":       credentialName,
+		"AuthType":   authType,
+		"SourcePath": sourcePath,
+	})
+}
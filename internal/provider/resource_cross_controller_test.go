@@ -4,11 +4,17 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/juju/juju/core/permission"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
 	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
 )
 
@@ -35,7 +41,7 @@ func TestAcc_ResourceIntegration_CrossControllers_Basic(t *testing.T) {
 		ProtoV6ProviderFactories: frameworkProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourceIntegrationCrossController(consumerModel, getOfferingControllerDataFromEnv(t)),
+				Config: testAccResourceIntegrationCrossController(consumerModel, getOfferingControllerDataFromEnv(t), false),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("juju_model.consumer", "uuid", "juju_integration.a", "model_uuid"),
 				),
@@ -49,7 +55,99 @@ func TestAcc_ResourceIntegration_CrossControllers_Basic(t *testing.T) {
 	})
 }
 
-func testAccResourceIntegrationCrossController(ConsumerModel string, offeringController offeringControllerData) string {
+func TestAcc_ResourceIntegration_CrossControllers_AutoGrantConsume(t *testing.T) {
+	OnlyCrossController(t)
+	consumerModel := acctest.RandomWithPrefix("tf-integration-consumer-cross-controller")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationCrossController(consumerModel, getOfferingControllerDataFromEnv(t), true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("juju_model.consumer", "uuid", "juju_integration.a", "model_uuid"),
+					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.a", "application.*",
+						map[string]string{"auto_grant_consume": "true"}),
+					// The offering controller user is an admin of the offer,
+					// so there is no access to grant.
+					resource.TestCheckResourceAttr("juju_integration.a", "consume_granted", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceIntegration_CrossControllers_AutoGrantConsume_Granted(t *testing.T) {
+	OnlyCrossController(t)
+	consumerUser, consumerPass := os.Getenv("OFFERING_CONTROLLER_CONSUMER_USERNAME"), os.Getenv("OFFERING_CONTROLLER_CONSUMER_PASSWORD")
+	if consumerUser == "" || consumerPass == "" {
+		t.Skip("OFFERING_CONTROLLER_CONSUMER_USERNAME and OFFERING_CONTROLLER_CONSUMER_PASSWORD are not set")
+	}
+	consumerModel := acctest.RandomWithPrefix("tf-integration-consumer-cross-controller")
+	offeringController := getOfferingControllerDataFromEnv(t)
+
+	// The provider consumes the offer as a user of the offering controller
+	// who has no access to the offer yet, so access is granted to that user.
+	consumerController := offeringController
+	consumerController.ControllerUser = consumerUser
+	consumerController.ControllerPass = consumerPass
+	consumerController.ControllerClientID = ""
+	consumerController.ControllerClientSecret = ""
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckOfferConsumeAccess(t, offeringController, consumerUser, false),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationCrossController(consumerModel, consumerController, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.a", "consume_granted", "true"),
+					testAccCheckOfferConsumeAccess(t, offeringController, consumerUser, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckOfferConsumeAccess checks, as the offering controller admin,
+// whether the user has consume access to the offer used by the tests.
+func testAccCheckOfferConsumeAccess(t *testing.T, offeringController offeringControllerData, user string, expected bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		adminController := offeringController.ControllerName + "-admin"
+		err := TestClient.Offers.AddOfferingController(t.Context(), adminController, juju.ControllerConfiguration{
+			ControllerAddresses: strings.Split(offeringController.ControllerAddr, ","),
+			Username:            offeringController.ControllerUser,
+			Password:            offeringController.ControllerPass,
+			CACert:              offeringController.ControllerCert,
+			ClientID:            offeringController.ControllerClientID,
+			ClientSecret:        offeringController.ControllerClientSecret,
+		})
+		if err != nil {
+			return err
+		}
+		offer, err := TestClient.Offers.ReadOffer(t.Context(), &juju.ReadOfferInput{
+			OfferURL:           "admin/offering-model.dummy-source",
+			OfferingController: adminController,
+		})
+		if err != nil {
+			return err
+		}
+		granted := false
+		for _, u := range offer.Users {
+			if u.UserName == user && u.Access == permission.ConsumeAccess {
+				granted = true
+			}
+		}
+		if granted != expected {
+			return fmt.Errorf("expected consume access of user %q to be %t, got %t", user, expected, granted)
+		}
+		return nil
+	}
+}
+
+func testAccResourceIntegrationCrossController(ConsumerModel string, offeringController offeringControllerData, autoGrantConsume bool) string {
 	return internaltesting.GetStringFromTemplateWithData("testAccResourceIntegrationCrossController",
 		`
 provider "juju" {
@@ -99,10 +197,14 @@ resource "juju_integration" "a" {
 		{{else}}
 		offer_url = "admin/offering-model.dummy-source" # offer url from offering controller
 		{{end}}
+		{{if .AutoGrantConsume}}
+		auto_grant_consume = true
+		{{end}}
 	}
 }
 `, internaltesting.TemplateData{
 			"ConsumerModel":          ConsumerModel,
+			"AutoGrantConsume":       autoGrantConsume,
 			"ControllerName":         offeringController.ControllerName,
 			"ControllerAddr":         offeringController.ControllerAddr,
 			"ControllerUser":         offeringController.ControllerUser,
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/names/v5"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
@@ -34,6 +37,8 @@ var _ resource.Resource = &integrationResource{}
 var _ resource.ResourceWithConfigure = &integrationResource{}
 var _ resource.ResourceWithImportState = &integrationResource{}
 var _ resource.ResourceWithIdentity = &integrationResource{}
+var _ resource.ResourceWithModifyPlan = &integrationResource{}
+var _ resource.ResourceWithValidateConfig = &integrationResource{}
 
 // NewIntegrationResource returns an integration resource.
 func NewIntegrationResource() resource.Resource {
@@ -49,8 +54,13 @@ type integrationResource struct {
 }
 
 type integrationResourceModel struct {
-	Via         types.String `tfsdk:"via"`
-	Application types.Set    `tfsdk:"application"`
+	Via                   types.String `tfsdk:"via"`
+	Application           types.Set    `tfsdk:"application"`
+	Suspended             types.Bool   `tfsdk:"suspended"`
+	SuspendedReason       types.String `tfsdk:"suspended_reason"`
+	RemoteSuspended       types.Bool   `tfsdk:"remote_suspended"`
+	RemoteSuspendedReason types.String `tfsdk:"remote_suspended_reason"`
+	ConsumeGranted        types.Bool   `tfsdk:"consume_granted"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -72,6 +82,7 @@ type nestedApplication struct {
 	Endpoint           types.String `tfsdk:"endpoint"`
 	OfferURL           types.String `tfsdk:"offer_url"`
 	OfferingController types.String `tfsdk:"offering_controller"`
+	AutoGrantConsume   types.Bool   `tfsdk:"auto_grant_consume"`
 }
 
 // ImportState imports a resource by ID.
@@ -137,6 +148,44 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"suspended": schema.BoolAttribute{
+				Description: "Whether the integration is suspended. Only cross-model integrations, i.e. those" +
+					" where one application uses offer_url, can be suspended. Changing this value suspends or" +
+					" resumes the integration in place.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"suspended_reason": schema.StringAttribute{
+				Description: "The reason given when suspending the integration.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					SuspendedReasonModifier(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("suspended")),
+				},
+			},
+			"remote_suspended": schema.BoolAttribute{
+				Description: "Whether the offering side reports the integration as suspended. Only set for" +
+					" cross-model integrations when the connections of the offer are visible to the user.",
+				Computed: true,
+			},
+			"remote_suspended_reason": schema.StringAttribute{
+				Description: "The reason reported by the offering side for suspending the integration.",
+				Computed:    true,
+			},
+			"consume_granted": schema.BoolAttribute{
+				Description: "Whether the provider granted consume access to the offer when creating the" +
+					" integration, see auto_grant_consume. The access is revoked when the integration is destroyed.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -173,8 +222,10 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 						},
 						"endpoint": schema.StringAttribute{
 							Description: "The endpoint name. This attribute may not be used at the" +
-								" same time as the offer_url.",
+								" same time as the offer_url. When omitted, the endpoint is resolved during" +
+								" planning if both applications already exist.",
 							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
 								stringplanmodifier.RequiresReplace(),
 							},
 							Optional: true,
@@ -213,6 +264,19 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("offer_url")),
 							},
 						},
+						"auto_grant_consume": schema.BoolAttribute{
+							Description: "Grant consume access to the offer to the user configured for the" +
+								" offering controller before consuming the offer, unless the user already has it. Access granted" +
+								" by the provider is revoked when the integration is destroyed. Requires offering_controller." +
+								" Changing this value will cause the integration to be destroyed and recreated by terraform.",
+							Optional: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.Bool{
+								boolvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("offering_controller")),
+							},
+						},
 					},
 				},
 			},
@@ -220,6 +284,234 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+// ValidateConfig rejects suspending an integration which does not go
+// through an offer, Juju only allows cross-model integrations to be
+// suspended. A suspension reason is only accepted when suspending.
+func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config integrationResourceModelV1
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.Suspended.IsUnknown() && !config.Suspended.ValueBool() &&
+		!config.SuspendedReason.IsUnknown() && !config.SuspendedReason.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("suspended_reason"), "Invalid Attribute Combination",
+			"suspended_reason can only be set when suspended is true.")
+		return
+	}
+	if !config.Suspended.ValueBool() || config.Application.IsUnknown() || config.Application.IsNull() {
+		return
+	}
+
+	var apps []nestedApplication
+	resp.Diagnostics.Append(config.Application.ElementsAs(ctx, &apps, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, app := range apps {
+		if !app.OfferURL.IsNull() {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(path.Root("suspended"), "Invalid Attribute Combination",
+		"Only cross-model integrations can be suspended, one of the applications must use offer_url.")
+}
+
+// ModifyPlan resolves the endpoints of the integration during planning.
+// Incompatible or ambiguous endpoints are reported before apply and the
+// resolved endpoints are shown in the plan. Resolution is skipped when the
+// endpoints of both applications are set, or when an application or offer
+// does not exist yet, e.g. because it is created in the same apply. Other
+// lookup failures are reported as warnings. Existing integrations keep the
+// endpoints in state.
+func (r *integrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve when destroying or when the provider is not configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan integrationResourceModelV1
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ModelUUID.IsUnknown() || plan.Application.IsUnknown() || plan.Application.IsNull() {
+		return
+	}
+
+	var apps []nestedApplication
+	resp.Diagnostics.Append(plan.Application.ElementsAs(ctx, &apps, false)...)
+	if resp.Diagnostics.HasError() || len(apps) != 2 {
+		return
+	}
+
+	// Existing integrations keep the endpoints in state, which are only
+	// unknown in the plan because another attribute changed.
+	creating := req.State.Raw.IsNull()
+	if !creating {
+		var state integrationResourceModelV1
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var stateApps []nestedApplication
+		resp.Diagnostics.Append(state.Application.ElementsAs(ctx, &stateApps, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if keepStateEndpoints(apps, stateApps) {
+			r.setPlannedApplications(ctx, req, resp, apps)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// Only resolve when an endpoint has to be computed. Endpoints set on
+	// both applications are left to Juju to check.
+	needsResolution := false
+	for _, app := range apps {
+		if app.OfferURL.IsUnknown() || (app.OfferURL.IsNull() && app.Name.IsUnknown()) {
+			return
+		}
+		if app.Endpoint.IsUnknown() || app.Endpoint.IsNull() {
+			needsResolution = true
+		}
+	}
+	if !needsResolution {
+		return
+	}
+
+	candidates, err := r.integrationCandidates(ctx, plan.ModelUUID.ValueString(), apps)
+	if err != nil {
+		r.trace(fmt.Sprintf("skipping endpoint resolution, %s", err))
+		switch {
+		case !creating:
+			// An unknown endpoint of an existing integration forces its
+			// replacement, explain why it could not be resolved.
+			resp.Diagnostics.AddAttributeWarning(path.Root("application"), "Integration Endpoints Not Resolved",
+				fmt.Sprintf("Unable to resolve the endpoints of the integration during planning, %s. The endpoints"+
+					" are known after apply, which replaces the integration. Set the endpoint of both applications"+
+					" to avoid it.", err))
+		case !errors.Is(err, errIntegrationApplicationNotFound):
+			// Applications created in the same apply are expected to be
+			// missing, other failures are worth reporting.
+			resp.Diagnostics.AddAttributeWarning(path.Root("application"), "Integration Endpoints Not Resolved",
+				fmt.Sprintf("Unable to resolve the endpoints of the integration during planning, %s. The endpoints"+
+					" are chosen by Juju during apply.", err))
+		}
+		return
+	}
+
+	pair, err := juju.InferIntegrationEndpoints(candidates[0], candidates[1])
+	switch {
+	case errors.Is(err, juju.IntegrationEndpointsAmbiguousError):
+		resp.Diagnostics.AddAttributeError(path.Root("application"), "Ambiguous Integration Endpoints",
+			fmt.Sprintf("%s\nSet the endpoint of at least one application to choose between them.", err))
+		return
+	case errors.Is(err, juju.IntegrationEndpointsIncompatibleError):
+		resp.Diagnostics.AddAttributeError(path.Root("application"), "Incompatible Integration Endpoints", err.Error())
+		return
+	case err != nil:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve integration endpoints, got error: %s", err))
+		return
+	}
+
+	apps[0].Endpoint = types.StringValue(pair.A.Name)
+	apps[1].Endpoint = types.StringValue(pair.B.Name)
+
+	r.setPlannedApplications(ctx, req, resp, apps)
+	r.trace(fmt.Sprintf("resolved integration endpoints %q and %q", pair.A.Name, pair.B.Name))
+}
+
+// setPlannedApplications sets the application blocks of the plan.
+func (r *integrationResource) setPlannedApplications(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, apps []nestedApplication) {
+	appsType := req.Plan.Schema.GetBlocks()["application"].(schema.SetNestedBlock).NestedObject.Type()
+	plannedApps, diags := types.SetValueFrom(ctx, appsType, apps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("application"), plannedApps)...)
+}
+
+// keepStateEndpoints sets the unknown endpoints of the planned
+// applications to the endpoints in state of the same application or
+// offer. It returns true if any endpoint was set.
+func keepStateEndpoints(apps, stateApps []nestedApplication) bool {
+	kept := false
+	for i := range apps {
+		if !apps[i].Endpoint.IsUnknown() {
+			continue
+		}
+		for _, stateApp := range stateApps {
+			sameApp := !apps[i].Name.IsUnknown() && apps[i].OfferURL.IsNull() && apps[i].Name.Equal(stateApp.Name)
+			sameOffer := !apps[i].OfferURL.IsNull() && apps[i].OfferURL.Equal(stateApp.OfferURL)
+			if (sameApp || sameOffer) && !stateApp.Endpoint.IsNull() {
+				apps[i].Endpoint = stateApp.Endpoint
+				kept = true
+				break
+			}
+		}
+	}
+	return kept
+}
+
+// errIntegrationApplicationNotFound is returned by integrationCandidates
+// when an application does not exist in the model yet.
+var errIntegrationApplicationNotFound = errors.New("application not found")
+
+// integrationCandidates returns the endpoints available on both sides of
+// the integration. It returns an error when the endpoints of either side
+// cannot be determined yet.
+func (r *integrationResource) integrationCandidates(ctx context.Context, modelUUID string, apps []nestedApplication) ([]juju.IntegrationCandidate, error) {
+	var appNames []string
+	for _, app := range apps {
+		if app.OfferURL.IsNull() {
+			appNames = append(appNames, app.Name.ValueString())
+		}
+	}
+
+	var appEndpoints map[string][]juju.CharmEndpoint
+	if len(appNames) > 0 {
+		response, err := r.client.Integrations.ApplicationEndpoints(ctx, &juju.ApplicationEndpointsInput{
+			ModelUUID:    modelUUID,
+			Applications: appNames,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to read application endpoints: %w", err)
+		}
+		appEndpoints = response.Endpoints
+	}
+
+	candidates := make([]juju.IntegrationCandidate, 0, len(apps))
+	for _, app := range apps {
+		candidate := juju.IntegrationCandidate{
+			Endpoint: app.Endpoint.ValueString(),
+		}
+		if app.OfferURL.IsNull() {
+			endpoints, ok := appEndpoints[app.Name.ValueString()]
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", errIntegrationApplicationNotFound, app.Name.ValueString())
+			}
+			candidate.Name = app.Name.ValueString()
+			candidate.Endpoints = endpoints
+		} else {
+			offerResponse, err := r.client.Offers.ReadOffer(ctx, &juju.ReadOfferInput{
+				OfferURL:           app.OfferURL.ValueString(),
+				OfferingController: app.OfferingController.ValueString(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("unable to read offer %q: %w", app.OfferURL.ValueString(), err)
+			}
+			candidate.Name = app.OfferURL.ValueString()
+			candidate.Endpoints = offerResponse.EndpointDetails
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
 func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Prevent panic if the provider has not been configured.
 	if r.client == nil {
@@ -250,7 +542,16 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 
 	// If we have an offer URL, we need to consume it (creating a remote-app) before creating the integration.
 	// If the remote-app already exists, we will re-use it (see `ConsumeRemoteOffer` for more details).
+	plan.ConsumeGranted = types.BoolValue(false)
 	if offer != nil {
+		if offer.autoGrantConsume {
+			granted, err := r.grantConsume(ctx, offer)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant consume access to offer %q, got error: %s", offer.url, err))
+				return
+			}
+			plan.ConsumeGranted = types.BoolValue(granted)
+		}
 		offerResponse, err := r.client.Offers.ConsumeRemoteOffer(ctx, &juju.ConsumeRemoteOfferInput{
 			ModelUUID:          modelUUID,
 			OfferURL:           offer.url,
@@ -258,9 +559,10 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 		})
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to consume remote offer, got error: %s", err))
+			r.revokeConsumeOnFailure(ctx, plan.ConsumeGranted.ValueBool(), offer, &resp.Diagnostics)
 			return
 		}
-		r.trace(fmt.Sprintf("remote offer created : %q", *offer))
+		r.trace(fmt.Sprintf("remote offer created : %q", offer.url))
 		// If the offer has a SAASName, we append it to the endpoints list
 		// If the endpoint is not empty, we append it in the format <SAASName>:<endpoint>
 		// If the endpoint is empty, we append just the SAASName and Juju will infer the endpoint.
@@ -282,6 +584,7 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration, got error: %s", err))
+		r.revokeConsumeOnFailure(ctx, plan.ConsumeGranted.ValueBool(), offer, &resp.Diagnostics)
 		return
 	}
 	r.trace(fmt.Sprintf("integration created on Juju between %q at %q on model %q", appNames, endpoints, modelUUID))
@@ -291,6 +594,7 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse applications, got error: %s", err))
 		return
 	}
+	copyAutoGrantConsume(parsedApplications, apps)
 
 	appsType := req.Plan.Schema.GetBlocks()["application"].(schema.SetNestedBlock).NestedObject.Type()
 	parsedApps, errDiag := types.SetValueFrom(ctx, appsType, parsedApplications)
@@ -303,6 +607,30 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	id := newIDForIntegrationResource(modelUUID, response.Applications)
 	plan.ID = types.StringValue(id)
 
+	_, endpointA, endpointB, idErr := modelUUIDAndEndpointsFromID(id)
+	if idErr.HasError() {
+		resp.Diagnostics.Append(idErr...)
+		return
+	}
+	// A failure to suspend still saves the integration, which exists in
+	// Juju, so that Terraform taints it rather than orphaning it.
+	var suspendErr error
+	if plan.Suspended.ValueBool() {
+		suspendErr = r.client.Integrations.SetIntegrationSuspended(ctx, &juju.SetIntegrationSuspendedInput{
+			ModelUUID: modelUUID,
+			Endpoints: []string{endpointA, endpointB},
+			Suspended: true,
+			Reason:    plan.SuspendedReason.ValueString(),
+		})
+	}
+	plan.Suspended = types.BoolValue(plan.Suspended.ValueBool() && suspendErr == nil)
+	if plan.Suspended.ValueBool() {
+		plan.SuspendedReason = types.StringValue(plan.SuspendedReason.ValueString())
+	} else {
+		plan.SuspendedReason = types.StringNull()
+	}
+	r.setRemoteSuspension(ctx, &plan.integrationResourceModel, modelUUID, parsedApplications)
+
 	r.trace(fmt.Sprintf("integration resource created: %q", id))
 	// Write the state plan into the Response.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -311,6 +639,10 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 		ID: plan.ID,
 	}
 	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+
+	if suspendErr != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to suspend integration, got error: %s", suspendErr))
+	}
 }
 
 func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -356,6 +688,15 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse applications, got error: %s", err))
 		return
 	}
+	var stateApps []nestedApplication
+	resp.Diagnostics.Append(state.Application.ElementsAs(ctx, &stateApps, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	copyAutoGrantConsume(applications, stateApps)
+	if state.ConsumeGranted.IsNull() {
+		state.ConsumeGranted = types.BoolValue(false)
+	}
 
 	appType := req.State.Schema.GetBlocks()["application"].(schema.SetNestedBlock).NestedObject.Type()
 	apps, aErr := types.SetValueFrom(ctx, appType, applications)
@@ -365,6 +706,14 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	state.Application = apps
 
+	state.Suspended = types.BoolValue(response.Suspended)
+	if response.Suspended {
+		state.SuspendedReason = types.StringValue(response.SuspendedReason)
+	} else {
+		state.SuspendedReason = types.StringNull()
+	}
+	r.setRemoteSuspension(ctx, &state.integrationResourceModel, modelUUID, applications)
+
 	r.trace(fmt.Sprintf("read integration resource: %v", state.ID.ValueString()))
 	// Set the state onto the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -375,8 +724,90 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
-// Update is a no-op, as all fields force replacement.
+// Update suspends or resumes the integration, all other fields force
+// replacement.
 func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "integration", "update")
+		return
+	}
+
+	var plan, state integrationResourceModelV1
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelUUID, endpointA, endpointB, idErr := modelUUIDAndEndpointsFromID(state.ID.ValueString())
+	if idErr.HasError() {
+		resp.Diagnostics.Append(idErr...)
+		return
+	}
+
+	if !plan.Suspended.Equal(state.Suspended) || !plan.SuspendedReason.IsUnknown() && !plan.SuspendedReason.Equal(state.SuspendedReason) {
+		err := r.client.Integrations.SetIntegrationSuspended(ctx, &juju.SetIntegrationSuspendedInput{
+			ModelUUID: modelUUID,
+			Endpoints: []string{endpointA, endpointB},
+			Suspended: plan.Suspended.ValueBool(),
+			Reason:    plan.SuspendedReason.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration suspension, got error: %s", err))
+			return
+		}
+		r.trace(fmt.Sprintf("integration %q suspended set to %t", state.ID.ValueString(), plan.Suspended.ValueBool()))
+	}
+
+	if plan.Suspended.ValueBool() {
+		plan.SuspendedReason = types.StringValue(plan.SuspendedReason.ValueString())
+	} else {
+		plan.SuspendedReason = types.StringNull()
+	}
+
+	var apps []nestedApplication
+	resp.Diagnostics.Append(plan.Application.ElementsAs(ctx, &apps, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.setRemoteSuspension(ctx, &plan.integrationResourceModel, modelUUID, apps)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// setRemoteSuspension populates the remote suspension attributes from the
+// connections of the offer used by the integration. The attributes are
+// null when the integration does not go through an offer or when the
+// connections of the offer are not visible to the user.
+func (r *integrationResource) setRemoteSuspension(ctx context.Context, model *integrationResourceModel, modelUUID string, apps []nestedApplication) {
+	model.RemoteSuspended = types.BoolNull()
+	model.RemoteSuspendedReason = types.StringNull()
+
+	for _, app := range apps {
+		if app.OfferURL.ValueString() == "" {
+			continue
+		}
+		offer, err := r.client.Offers.ReadOffer(ctx, &juju.ReadOfferInput{
+			OfferURL:           app.OfferURL.ValueString(),
+			OfferingController: app.OfferingController.ValueString(),
+		})
+		if err != nil {
+			r.trace(fmt.Sprintf("unable to read offer %q for remote suspension status: %s", app.OfferURL.ValueString(), err))
+			return
+		}
+		for _, conn := range offer.Connections {
+			if conn.SourceModelUUID != modelUUID || conn.Endpoint != app.Endpoint.ValueString() {
+				continue
+			}
+			model.RemoteSuspended = types.BoolValue(conn.Suspended())
+			if conn.Suspended() {
+				model.RemoteSuspendedReason = types.StringValue(conn.Message)
+			}
+			return
+		}
+		return
+	}
 }
 
 // Delete removes the integration and intentionally avoids deleting any consumed offers
@@ -438,9 +869,119 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 			return
 		}
 	}
+
+	if state.ConsumeGranted.ValueBool() {
+		var apps []nestedApplication
+		resp.Diagnostics.Append(state.Application.ElementsAs(ctx, &apps, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, app := range apps {
+			if app.OfferURL.ValueString() == "" {
+				continue
+			}
+			err := r.revokeConsume(ctx, app.OfferURL.ValueString(), app.OfferingController.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke consume access to offer %q, got error: %s", app.OfferURL.ValueString(), err))
+				return
+			}
+			r.trace(fmt.Sprintf("revoked consume access to offer %q", app.OfferURL.ValueString()))
+		}
+	}
 	r.trace(fmt.Sprintf("Deleted integration resource: %q", state.ID.ValueString()))
 }
 
+// consumeUser returns the user consuming offers of the given offering
+// controller: the user configured for that controller, or the provider's
+// user when the offer is on the provider's controller.
+func (r *integrationResource) consumeUser(offeringController string) (string, error) {
+	if offeringController == "" {
+		return r.client.Username(), nil
+	}
+	return r.client.Offers.GetOfferingControllerUser(offeringController)
+}
+
+// grantConsume grants consume access to the offer to the user consuming
+// it on the offering controller. It returns false if the user already had
+// consume or admin access, so no access was granted.
+func (r *integrationResource) grantConsume(ctx context.Context, of *offer) (bool, error) {
+	user, err := r.consumeUser(of.offeringController)
+	if err != nil {
+		return false, err
+	}
+	// Users are only visible to offer admins, if the offer cannot be read
+	// the grant below reports any permission issue.
+	response, err := r.client.Offers.ReadOffer(ctx, &juju.ReadOfferInput{
+		OfferURL:           of.url,
+		OfferingController: of.offeringController,
+	})
+	if err == nil {
+		for _, u := range response.Users {
+			if u.UserName != user {
+				continue
+			}
+			if u.Access == permission.ConsumeAccess || u.Access == permission.AdminAccess {
+				r.trace(fmt.Sprintf("user %q already has %q access to offer %q", user, u.Access, of.url))
+				return false, nil
+			}
+		}
+	}
+
+	err = r.client.Offers.GrantOffer(ctx, &juju.GrantRevokeOfferInput{
+		Users:              []string{user},
+		Access:             string(permission.ConsumeAccess),
+		OfferURL:           of.url,
+		OfferingController: of.offeringController,
+	})
+	if err != nil {
+		return false, err
+	}
+	r.trace(fmt.Sprintf("granted consume access to offer %q to user %q", of.url, user))
+	return true, nil
+}
+
+// revokeConsume revokes the consume access granted by grantConsume.
+func (r *integrationResource) revokeConsume(ctx context.Context, offerURL, offeringController string) error {
+	user, err := r.consumeUser(offeringController)
+	if err != nil {
+		return err
+	}
+	return r.client.Offers.RevokeOffer(ctx, &juju.GrantRevokeOfferInput{
+		Users:              []string{user},
+		Access:             string(permission.ConsumeAccess),
+		OfferURL:           offerURL,
+		OfferingController: offeringController,
+	})
+}
+
+// revokeConsumeOnFailure revokes the consume access granted during a
+// create which failed afterwards, so the grant is not left behind.
+func (r *integrationResource) revokeConsumeOnFailure(ctx context.Context, granted bool, of *offer, diags *diag.Diagnostics) {
+	if !granted || of == nil {
+		return
+	}
+	if err := r.revokeConsume(ctx, of.url, of.offeringController); err != nil {
+		diags.AddWarning("Client Error", fmt.Sprintf("Unable to revoke consume access to offer %q after a failed create, got error: %s", of.url, err))
+		return
+	}
+	r.trace(fmt.Sprintf("revoked consume access to offer %q after a failed create", of.url))
+}
+
+// copyAutoGrantConsume copies auto_grant_consume from the configured
+// applications to the applications read from Juju, which cannot report it.
+func copyAutoGrantConsume(dst, src []nestedApplication) {
+	for _, s := range src {
+		if s.OfferURL.ValueString() == "" {
+			continue
+		}
+		for i := range dst {
+			if dst[i].OfferURL.ValueString() != "" {
+				dst[i].AutoGrantConsume = s.AutoGrantConsume
+			}
+		}
+	}
+}
+
 func handleIntegrationNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
 	if errors.Is(err, juju.IntegrationNotFoundError) {
 		// Integration manually removed
@@ -489,6 +1030,7 @@ type offer struct {
 	url                string
 	endpoint           string
 	offeringController string
+	autoGrantConsume   bool
 }
 
 // This function can be used to parse the terraform data into usable juju endpoints
@@ -508,6 +1050,7 @@ func parseEndpoints(apps []nestedApplication) (endpoints []string, of *offer, ap
 				url:                offerURL,
 				endpoint:           endpoint,
 				offeringController: offeringController,
+				autoGrantConsume:   app.AutoGrantConsume.ValueBool(),
 			}
 			continue
 		}
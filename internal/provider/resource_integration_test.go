@@ -8,17 +8,41 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
 
 	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
 )
 
+func TestKeepStateEndpoints(t *testing.T) {
+	app := func(name, endpoint string) nestedApplication {
+		return nestedApplication{Name: types.StringValue(name), Endpoint: types.StringValue(endpoint), OfferURL: types.StringNull()}
+	}
+	offer := func(url, endpoint string) nestedApplication {
+		return nestedApplication{Name: types.StringValue(""), Endpoint: types.StringValue(endpoint), OfferURL: types.StringValue(url)}
+	}
+	stateApps := []nestedApplication{app("a", "source"), offer("admin/b.b", "sink")}
+
+	apps := []nestedApplication{app("a", "source"), offer("admin/b.b", "")}
+	apps[1].Endpoint = types.StringUnknown()
+	assert.True(t, keepStateEndpoints(apps, stateApps))
+	assert.Equal(t, types.StringValue("sink"), apps[1].Endpoint)
+
+	// A new application has no endpoint in state.
+	apps = []nestedApplication{app("c", ""), offer("admin/b.b", "sink")}
+	apps[0].Endpoint = types.StringUnknown()
+	assert.False(t, keepStateEndpoints(apps, stateApps))
+	assert.True(t, apps[0].Endpoint.IsUnknown())
+}
+
 func TestAcc_ResourceIntegration(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -194,6 +218,64 @@ func TestAcc_ResourceIntegration_UpgradeProvider(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceIntegrationResolveEndpointsAtPlan(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-integration")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationResolveEndpoints(modelName, false, ""),
+			},
+			{
+				// The endpoints are resolved and shown in the plan.
+				Config: testAccResourceIntegrationResolveEndpoints(modelName, true, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("juju_integration.this", tfjsonpath.New("application"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"name":     knownvalue.StringExact("one"),
+								"endpoint": knownvalue.StringExact("source"),
+							}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"name":     knownvalue.StringExact("two"),
+								"endpoint": knownvalue.StringExact("sink"),
+							}),
+						})),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceIntegrationIncompatibleEndpointsAtPlan(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-integration")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationResolveEndpoints(modelName, false, ""),
+			},
+			{
+				Config:      testAccResourceIntegrationResolveEndpoints(modelName, true, "juju-info"),
+				ExpectError: regexp.MustCompile(`Incompatible Integration Endpoints`),
+			},
+		},
+	})
+}
+
 func testAccCheckIntegrationDestroy(s *terraform.State) error {
 	return nil
 }
@@ -240,6 +322,57 @@ resource "juju_integration" "this" {
 `, modelName)
 }
 
+func testAccResourceIntegrationResolveEndpoints(modelName string, integrate bool, endpoint string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceIntegrationResolveEndpoints",
+		`
+resource "juju_model" "this" {
+	name = "{{.ModelName}}"
+}
+
+resource "juju_application" "one" {
+	model_uuid = juju_model.this.uuid
+	name       = "one"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_application" "two" {
+	model_uuid = juju_model.this.uuid
+	name       = "two"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+{{- if .Integrate }}
+resource "juju_integration" "this" {
+	model_uuid = juju_model.this.uuid
+
+	application {
+		name = juju_application.one.name
+		{{- if .Endpoint }}
+		endpoint = "{{.Endpoint}}"
+		{{- end }}
+	}
+
+	application {
+		name = juju_application.two.name
+	}
+}
+{{- end }}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+			"Integrate": integrate,
+			"Endpoint":  endpoint,
+		})
+}
+
 func testAccResourceIntegrationWithNullVars(modelName string) string {
 	return fmt.Sprintf(`
 resource "juju_model" "this" {
@@ -409,6 +542,165 @@ resource "juju_integration" "a" {
 `, srcModelName, aOS, dstModelName, bOS, viaCIDRs)
 }
 
+func TestAcc_ResourceIntegrationSuspended(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	srcModelName := acctest.RandomWithPrefix("tf-test-integration")
+	dstModelName := acctest.RandomWithPrefix("tf-test-integration-dst")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationSuspended(srcModelName, dstModelName, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.a", "suspended", "false"),
+					resource.TestCheckNoResourceAttr("juju_integration.a", "suspended_reason"),
+				),
+			},
+			{
+				Config: testAccResourceIntegrationSuspended(srcModelName, dstModelName, true, "maintenance"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("juju_integration.a", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.a", "suspended", "true"),
+					resource.TestCheckResourceAttr("juju_integration.a", "suspended_reason", "maintenance"),
+				),
+			},
+			{
+				Config: testAccResourceIntegrationSuspended(srcModelName, dstModelName, false, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("juju_integration.a", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.a", "suspended", "false"),
+					resource.TestCheckNoResourceAttr("juju_integration.a", "suspended_reason"),
+				),
+			},
+			// Suspending without a reason keeps the computed endpoint of the offer.
+			{
+				Config: testAccResourceIntegrationSuspended(srcModelName, dstModelName, true, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("juju_integration.a", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("juju_integration.a", tfjsonpath.New("suspended_reason"), knownvalue.StringExact("")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.a", "suspended", "true"),
+					resource.TestCheckResourceAttr("juju_integration.a", "suspended_reason", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceIntegrationSuspendedRequiresOffer(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-integration")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_integration" "this" {
+	model_uuid = juju_model.this.uuid
+	suspended  = true
+
+	application {
+		name = "one"
+	}
+
+	application {
+		name = "two"
+	}
+}
+`, modelName),
+				ExpectError: regexp.MustCompile(`Only cross-model integrations can be suspended`),
+			},
+		},
+	})
+}
+
+func testAccResourceIntegrationSuspended(srcModelName, dstModelName string, suspended bool, reason string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceIntegrationSuspended",
+		`
+resource "juju_model" "a" {
+	name = "{{.SrcModelName}}"
+}
+
+resource "juju_application" "a" {
+	model_uuid = juju_model.a.uuid
+	name       = "a"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_model" "b" {
+	name = "{{.DstModelName}}"
+}
+
+resource "juju_application" "b" {
+	model_uuid = juju_model.b.uuid
+	name       = "b"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "b" {
+	model_uuid          = juju_model.b.uuid
+	application_name    = juju_application.b.name
+	endpoints           = ["sink"]
+	allow_force_destroy = true
+}
+
+resource "juju_integration" "a" {
+	model_uuid = juju_model.a.uuid
+	suspended  = {{.Suspended}}
+	{{- if .Reason }}
+	suspended_reason = "{{.Reason}}"
+	{{- end }}
+
+	application {
+		name     = juju_application.a.name
+		endpoint = "source"
+	}
+
+	application {
+		offer_url = juju_offer.b.url
+	}
+}
+`, internaltesting.TemplateData{
+			"SrcModelName": srcModelName,
+			"DstModelName": dstModelName,
+			"Suspended":    suspended,
+			"Reason":       reason,
+		})
+}
+
 func TestAcc_ResourceIntegrationWithMultipleConsumers(t *testing.T) {
 	SkipAgainstJuju4WithReason(t, "See https://github.com/juju/juju/issues/22213")
 	if testingCloud != LXDCloudTesting {
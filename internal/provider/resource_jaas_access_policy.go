@@ -0,0 +1,403 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &jaasAccessPolicyResource{}
+var _ resource.ResourceWithConfigure = &jaasAccessPolicyResource{}
+var _ resource.ResourceWithImportState = &jaasAccessPolicyResource{}
+var _ resource.ResourceWithConfigValidators = &jaasAccessPolicyResource{}
+
+// NewJAASAccessPolicyResource returns a new resource owning every JAAS
+// relation of a target.
+func NewJAASAccessPolicyResource() resource.Resource {
+	return &jaasAccessPolicyResource{}
+}
+
+type jaasAccessPolicyResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type jaasAccessPolicyResourceModel struct {
+	Target types.String `tfsdk:"target"`
+	Tuples types.Set    `tfsdk:"tuples"`
+
+	// ID required for imports
+	ID types.String `tfsdk:"id"`
+}
+
+type jaasAccessPolicyTupleModel struct {
+	Object   types.String `tfsdk:"object"`
+	Relation types.String `tfsdk:"relation"`
+}
+
+var jaasAccessPolicyTupleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"object":   types.StringType,
+		"relation": types.StringType,
+	},
+}
+
+// Metadata returns metadata about the JAAS access policy resource.
+func (r *jaasAccessPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_access_policy"
+}
+
+// ConfigValidators sets validators for the access policy resource.
+func (r *jaasAccessPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewResourceRequiresJAASValidator(r.client),
+	}
+}
+
+// Schema defines the schema for the JAAS access policy resource.
+func (r *jaasAccessPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that owns every relation of a JAAS target. Relations to the target that are" +
+			" not listed, including those granted with jimmctl or other resources, are removed on the next" +
+			" apply. It must not be combined with juju_jaas_access_* resources for the same target." +
+			" The structural `controller` and `model` relations JAAS maintains between its objects are not managed.",
+		Attributes: map[string]schema.Attribute{
+			"target": schema.StringAttribute{
+				Description: "The tag of the target of the relations, e.g. `model-<uuid>`, `group-<uuid>` or" +
+					" `controller-jimm` for JAAS itself. Changing this value will cause the access policy to be" +
+					" destroyed and recreated by terraform.",
+				Required: true,
+				Validators: []validator.String{
+					ValidatorMatchString(isValidJAASTag, "must be a valid JAAS tag"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tuples": schema.SetNestedAttribute{
+				Description: "The complete set of relations to the target.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object": schema.StringAttribute{
+							Description: "The tag of the object of the relation, e.g. `user-alice@canonical.com`," +
+								" `group-<uuid>#member` or `role-<uuid>#assignee`.",
+							Required: true,
+							Validators: []validator.String{
+								ValidatorMatchString(isValidJAASTag, "must be a valid JAAS tag"),
+							},
+						},
+						"relation": schema.StringAttribute{
+							Description: "The relation of the object to the target, e.g. `reader`, `writer` or `administrator`." +
+								" The structural `controller` and `model` relations cannot be set.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.NoneOf(jaasStructuralRelations...),
+							},
+						},
+					},
+				},
+			},
+			// ID required for imports
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined Resource type.
+func (r *jaasAccessPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderData(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = provider.Client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASAccessPolicy)
+}
+
+// Create replaces the relations of the target with the planned ones.
+func (r *jaasAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASAccessPolicy, "create")
+		return
+	}
+
+	var plan jaasAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyPolicy(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Target
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read sets the tuples to every relation of the target, so that
+// relations added outside of terraform are planned for removal.
+func (r *jaasAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASAccessPolicy, "read")
+		return
+	}
+
+	var state jaasAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := state.ID.ValueString()
+	current, err := r.client.Jaas.ReadRelations(ctx, &juju.JaasTuple{Target: target})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relations of %q, got error: %s", target, err))
+		return
+	}
+
+	current = jaasAccessTuples(current)
+	tuples := make([]jaasAccessPolicyTupleModel, 0, len(current))
+	for _, tuple := range current {
+		tuples = append(tuples, jaasAccessPolicyTupleModel{
+			Object:   types.StringValue(tuple.Object),
+			Relation: types.StringValue(tuple.Relation),
+		})
+	}
+	tuplesSet, dErr := types.SetValueFrom(ctx, jaasAccessPolicyTupleType, tuples)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Target = types.StringValue(target)
+	state.Tuples = tuplesSet
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update replaces the relations of the target with the planned ones.
+func (r *jaasAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASAccessPolicy, "update")
+		return
+	}
+
+	var plan jaasAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyPolicy(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the relations of the policy which still exist.
+func (r *jaasAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASAccessPolicy, "delete")
+		return
+	}
+
+	var state jaasAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned, dErr := jaasAccessPolicyTuples(ctx, state)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := state.Target.ValueString()
+	current, err := r.client.Jaas.ReadRelations(ctx, &juju.JaasTuple{Target: target})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relations of %q, got error: %s", target, err))
+		return
+	}
+
+	// Only remove the owned relations which are still in place, the
+	// others being the ones that remain once they are gone.
+	_, remaining := jaasTupleDelta(current, owned)
+	_, toRemove := jaasTupleDelta(current, remaining)
+	if len(toRemove) == 0 {
+		return
+	}
+	if err := r.client.Jaas.DeleteRelations(ctx, toRemove); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete relations of %q, got error: %s", target, err))
+	}
+}
+
+// ImportState adopts every existing relation of the target, the ID
+// being the tag of the target.
+func (r *jaasAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !isValidJAASTag(req.ID) {
+		resp.Diagnostics.AddError(
+			"ImportState Failure",
+			fmt.Sprintf("Malformed access policy ID %q, please use the tag of the target, e.g. model-<uuid>", req.ID),
+		)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyPolicy adds the planned relations missing from the target, then
+// removes the ones which are not planned.
+func (r *jaasAccessPolicyResource) applyPolicy(ctx context.Context, plan jaasAccessPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	desired, dErr := jaasAccessPolicyTuples(ctx, plan)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return diags
+	}
+
+	target := plan.Target.ValueString()
+	current, err := r.client.Jaas.ReadRelations(ctx, &juju.JaasTuple{Target: target})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read relations of %q, got error: %s", target, err))
+		return diags
+	}
+
+	toAdd, toRemove := jaasTupleDelta(current, desired)
+	// Add first so that objects moving between relations do not lose
+	// access in between.
+	if len(toAdd) > 0 {
+		if err := r.client.Jaas.AddRelations(ctx, toAdd); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add relations to %q, got error: %s", target, err))
+			return diags
+		}
+	}
+	if len(toRemove) > 0 {
+		if err := r.client.Jaas.DeleteRelations(ctx, toRemove); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete relations of %q, got error: %s", target, err))
+			return diags
+		}
+	}
+	r.trace(fmt.Sprintf("applied access policy of %q", target), map[string]interface{}{
+		"added": len(toAdd), "removed": len(toRemove),
+	})
+	return diags
+}
+
+func (r *jaasAccessPolicyResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceJAASAccessPolicy, msg, additionalFields...)
+}
+
+// jaasAccessPolicyTuples returns the relations of the policy.
+func jaasAccessPolicyTuples(ctx context.Context, model jaasAccessPolicyResourceModel) ([]juju.JaasTuple, diag.Diagnostics) {
+	var tuples []jaasAccessPolicyTupleModel
+	diags := model.Tuples.ElementsAs(ctx, &tuples, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	out := make([]juju.JaasTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		out = append(out, juju.JaasTuple{
+			Object:   tuple.Object.ValueString(),
+			Relation: tuple.Relation.ValueString(),
+			Target:   model.Target.ValueString(),
+		})
+	}
+	return out, diags
+}
+
+// jaasStructuralRelations are the relations JAAS maintains between its
+// objects, e.g. a model and its controller. They grant no access and
+// are never owned by a policy.
+var jaasStructuralRelations = []string{"controller", "model"}
+
+// jaasAccessTuples returns the tuples of access relations, leaving out
+// the structural ones.
+func jaasAccessTuples(tuples []juju.JaasTuple) []juju.JaasTuple {
+	out := make([]juju.JaasTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		if slices.Contains(jaasStructuralRelations, tuple.Relation) {
+			continue
+		}
+		out = append(out, tuple)
+	}
+	return out
+}
+
+// jaasTupleDelta returns the tuples to add to and remove from current to
+// obtain desired, sorted by object and relation. Structural relations in
+// current are left untouched.
+func jaasTupleDelta(current, desired []juju.JaasTuple) (toAdd, toRemove []juju.JaasTuple) {
+	current = jaasAccessTuples(current)
+	key := func(tuple juju.JaasTuple) string {
+		return tuple.Object + "\x00" + tuple.Relation
+	}
+	currentKeys := make(map[string]bool, len(current))
+	for _, tuple := range current {
+		currentKeys[key(tuple)] = true
+	}
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, tuple := range desired {
+		desiredKeys[key(tuple)] = true
+		if !currentKeys[key(tuple)] {
+			toAdd = append(toAdd, tuple)
+		}
+	}
+	for _, tuple := range current {
+		if !desiredKeys[key(tuple)] {
+			toRemove = append(toRemove, tuple)
+		}
+	}
+	sortTuples := func(tuples []juju.JaasTuple) {
+		sort.Slice(tuples, func(i, j int) bool {
+			return key(tuples[i]) < key(tuples[j])
+		})
+	}
+	sortTuples(toAdd)
+	sortTuples(toRemove)
+	return toAdd, toRemove
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestJaasTupleDelta(t *testing.T) {
+	tuple := func(object, relation string) juju.JaasTuple {
+		return juju.JaasTuple{Object: object, Relation: relation, Target: "group-target"}
+	}
+	current := []juju.JaasTuple{
+		tuple("user-alice@canonical.com", "member"),
+		tuple("user-bob@canonical.com", "member"),
+	}
+	desired := []juju.JaasTuple{
+		tuple("user-carol@canonical.com", "member"),
+		tuple("user-alice@canonical.com", "member"),
+	}
+
+	toAdd, toRemove := jaasTupleDelta(current, desired)
+	assert.Equal(t, []juju.JaasTuple{tuple("user-carol@canonical.com", "member")}, toAdd)
+	assert.Equal(t, []juju.JaasTuple{tuple("user-bob@canonical.com", "member")}, toRemove)
+
+	toAdd, toRemove = jaasTupleDelta(current, current)
+	assert.Empty(t, toAdd)
+	assert.Empty(t, toRemove)
+}
+
+func TestJaasTupleDeltaStructuralRelations(t *testing.T) {
+	tuple := func(object, relation string) juju.JaasTuple {
+		return juju.JaasTuple{Object: object, Relation: relation, Target: "model-target"}
+	}
+	current := []juju.JaasTuple{
+		tuple("controller-00000000-0000-0000-0000-000000000001", "controller"),
+		tuple("user-alice@canonical.com", "reader"),
+	}
+	desired := []juju.JaasTuple{
+		tuple("user-bob@canonical.com", "writer"),
+	}
+
+	// The controller of the model is not owned by the policy, so it is
+	// never removed.
+	toAdd, toRemove := jaasTupleDelta(current, desired)
+	assert.Equal(t, []juju.JaasTuple{tuple("user-bob@canonical.com", "writer")}, toAdd)
+	assert.Equal(t, []juju.JaasTuple{tuple("user-alice@canonical.com", "reader")}, toRemove)
+
+	toAdd, toRemove = jaasTupleDelta(current, nil)
+	assert.Empty(t, toAdd)
+	assert.Equal(t, []juju.JaasTuple{tuple("user-alice@canonical.com", "reader")}, toRemove)
+}
+
+func TestAcc_ResourceJaasAccessPolicy(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	groupName := acctest.RandomWithPrefix("tf-jaas-group")
+	userOne := acctest.RandomWithPrefix("tfuser") + "@canonical.com"
+	userTwo := acctest.RandomWithPrefix("tfuser") + "@canonical.com"
+
+	resourceName := "juju_jaas_access_policy.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJaasAccessPolicy(groupName, userOne, []string{userOne}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tuples.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tuples.*", map[string]string{
+						"object":   "user-" + userOne,
+						"relation": "member",
+					}),
+					resource.TestCheckResourceAttr("data.juju_jaas_check.user_one", "allowed", "true"),
+				),
+			},
+			{
+				Config: testAccResourceJaasAccessPolicy(groupName, userOne, []string{userTwo}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tuples.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tuples.*", map[string]string{
+						"object":   "user-" + userTwo,
+						"relation": "member",
+					}),
+					resource.TestCheckResourceAttr("data.juju_jaas_check.user_one", "allowed", "false"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+// testAccResourceJaasAccessPolicy makes the members the only members of
+// the group, and checks whether checkedUser is a member.
+func testAccResourceJaasAccessPolicy(groupName, checkedUser string, members []string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceJaasAccessPolicy",
+		`
+resource "juju_jaas_group" "test" {
+  name = "{{ .GroupName }}"
+}
+
+resource "juju_jaas_access_policy" "test" {
+  target = "group-${juju_jaas_group.test.uuid}"
+  tuples = [
+  {{- range .Members }}
+    {
+      object   = "user-{{ . }}"
+      relation = "member"
+    },
+  {{- end }}
+  ]
+}
+
+data "juju_jaas_check" "user_one" {
+  object   = "user-{{ .CheckedUser }}"
+  relation = "member"
+  target   = juju_jaas_access_policy.test.target
+}
+`, internaltesting.TemplateData{
+			"GroupName":   groupName,
+			"CheckedUser": checkedUser,
+			"Members":     members,
+		})
+}
@@ -0,0 +1,356 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jujucloud "github.com/juju/juju/cloud"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &jaasCloudResource{}
+var _ resource.ResourceWithConfigure = &jaasCloudResource{}
+var _ resource.ResourceWithConfigValidators = &jaasCloudResource{}
+
+// NewJAASCloudResource returns a new instance of the JAAS cloud resource.
+func NewJAASCloudResource() resource.Resource {
+	return &jaasCloudResource{}
+}
+
+type jaasCloudResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem.
+	subCtx context.Context
+}
+
+type jaasCloudResourceModel struct {
+	Controller       types.String `tfsdk:"controller"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	AuthTypes        types.Set    `tfsdk:"auth_types"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	IdentityEndpoint types.String `tfsdk:"identity_endpoint"`
+	StorageEndpoint  types.String `tfsdk:"storage_endpoint"`
+	CACertificates   types.Set    `tfsdk:"ca_certificates"`
+	Regions          types.List   `tfsdk:"regions"`
+
+	// Read-only fields returned by JAAS.
+	Controllers types.Set `tfsdk:"controllers"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *jaasCloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_cloud"
+}
+
+// Schema defines the schema for the resource.
+func (r *jaasCloudResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that adds a cloud to JAAS, hosted on a chosen controller registered in JAAS." +
+			" JAAS does not support changing the definition of a cloud, any change replaces the cloud.",
+		Attributes: map[string]schema.Attribute{
+			"controller": schema.StringAttribute{
+				Description: "The name of the JAAS controller the cloud is added to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the cloud in JAAS.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the cloud.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_types": schema.SetAttribute{
+				Description: "List of supported authentication types by the cloud.",
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "Optional global endpoint for the cloud.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_endpoint": schema.StringAttribute{
+				Description: "Optional global identity endpoint for the cloud.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage_endpoint": schema.StringAttribute{
+				Description: "Optional global storage endpoint for the cloud.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ca_certificates": schema.SetAttribute{
+				Description: "List of PEM-encoded X509 certificates for the cloud.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Validators:  []validator.Set{ValidateCACertificatesPEM()},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"regions": schema.ListNestedAttribute{
+				Description: "List of regions for the cloud. The first region in the list is the default region for the cloud.",
+				Computed:    true,
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{Required: true, Description: "Name of the region."},
+						"endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "Region-specific endpoint.",
+							Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"identity_endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "Region-specific identity endpoint.",
+							Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"storage_endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "Region-specific storage endpoint.",
+							Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"config": schema.MapAttribute{
+							Optional:    true,
+							Description: "Region-specific configuration, used as defaults for the models in the region.",
+							ElementType: types.StringType,
+						},
+					},
+				},
+				Default: defaultRegionForCloud{},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"controllers": schema.SetAttribute{
+				Description: "The names of the JAAS controllers hosting the cloud.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the provider configured client to the resource.
+func (r *jaasCloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderData(req, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = provider.Client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASCloud)
+}
+
+// ConfigValidators sets validators for the resource.
+func (r *jaasCloudResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewResourceRequiresJAASValidator(r.client),
+	}
+}
+
+// Create adds the cloud to the chosen JAAS controller.
+func (r *jaasCloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "resource-jaas-cloud", "create")
+		return
+	}
+
+	var plan jaasCloudResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regions, regionConfig := expandRegions(ctx, plan.Regions, &resp.Diagnostics)
+	authTypes := expandStringList(ctx, plan.AuthTypes, &resp.Diagnostics)
+	cacerts := expandStringList(ctx, plan.CACertificates, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	at := make(jujucloud.AuthTypes, len(authTypes))
+	for i, s := range authTypes {
+		at[i] = jujucloud.AuthType(s)
+	}
+
+	err := r.client.Jaas.AddCloudToController(ctx, juju.AddCloudToControllerInput{
+		AddCloudInput: juju.AddCloudInput{
+			Name:             plan.Name.ValueString(),
+			Type:             plan.Type.ValueString(),
+			AuthTypes:        at,
+			Endpoint:         plan.Endpoint.ValueString(),
+			IdentityEndpoint: plan.IdentityEndpoint.ValueString(),
+			StorageEndpoint:  plan.StorageEndpoint.ValueString(),
+			Regions:          regions,
+			RegionConfig:     regionConfig,
+			CACertificates:   cacerts,
+		},
+		ControllerName: plan.Controller.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add cloud %q to JAAS controller %q, got error: %s",
+			plan.Name.ValueString(), plan.Controller.ValueString(), err))
+		return
+	}
+	r.trace(fmt.Sprintf("added cloud %s to controller %s", plan.Name.ValueString(), plan.Controller.ValueString()))
+
+	controllers, err := r.client.Jaas.ReadCloudControllers(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controllers of cloud %q, got error: %s", plan.Name.ValueString(), err))
+		return
+	}
+	controllersSet, dErr := types.SetValueFrom(ctx, types.StringType, controllers)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Controllers = controllersSet
+	plan.ID = types.StringValue(plan.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the controllers hosting the cloud. The cloud is removed
+// from the state when it no longer exists or is no longer hosted on the
+// chosen controller.
+func (r *jaasCloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "resource-jaas-cloud", "read")
+		return
+	}
+
+	var state jaasCloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Clouds.ReadCloud(ctx, juju.ReadCloudInput{Name: state.Name.ValueString()})
+	if errors.Is(err, juju.CloudNotFoundError) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud %q, got error: %s", state.Name.ValueString(), err))
+		return
+	}
+
+	controllers, err := r.client.Jaas.ReadCloudControllers(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controllers of cloud %q, got error: %s", state.Name.ValueString(), err))
+		return
+	}
+	if !slices.Contains(controllers, state.Controller.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	controllersSet, dErr := types.SetValueFrom(ctx, types.StringType, controllers)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Controllers = controllersSet
+
+	r.trace(fmt.Sprintf("read cloud %s", state.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores the plan, since every configurable attribute
+// requires the cloud to be replaced.
+func (r *jaasCloudResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jaasCloudResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the cloud from the chosen JAAS controller.
+func (r *jaasCloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "resource-jaas-cloud", "delete")
+		return
+	}
+
+	var state jaasCloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Jaas.RemoveCloudFromController(ctx, state.Name.ValueString(), state.Controller.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove cloud %q from JAAS controller %q, got error: %s",
+			state.Name.ValueString(), state.Controller.ValueString(), err))
+		return
+	}
+	r.trace(fmt.Sprintf("removed cloud %s from controller %s", state.Name.ValueString(), state.Controller.ValueString()))
+}
+
+func (r *jaasCloudResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+	tflog.SubsystemTrace(r.subCtx, LogResourceJAASCloud, msg, additionalFields...)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_ResourceJAASCloud(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+
+	testAccPreCheck(t)
+
+	controllers, err := TestClient.Jaas.ListControllers(t.Context())
+	if err != nil || len(controllers) == 0 {
+		t.Fatalf("unable to list controllers from JAAS: %v", err)
+	}
+	controllerName := controllers[0].Name
+	cloudName := acctest.RandomWithPrefix("tf-jaas-cloud")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJAASCloud(controllerName, cloudName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_jaas_cloud.test", "id", cloudName),
+					resource.TestCheckResourceAttr("juju_jaas_cloud.test", "regions.0.name", "default"),
+					resource.TestCheckTypeSetElemAttr("juju_jaas_cloud.test", "controllers.*", controllerName),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceJAASCloud(controllerName, cloudName string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceJAASCloud",
+		`
+resource "juju_jaas_cloud" "test" {
+  controller = "{{ .ControllerName }}"
+  name       = "{{ .CloudName }}"
+  type       = "openstack"
+  auth_types = ["userpass"]
+}
+`, internaltesting.TemplateData{
+			"ControllerName": controllerName,
+			"CloudName":      cloudName,
+		})
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/canonical/jimm-go-sdk/v3/api/params"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -29,6 +31,12 @@ var _ resource.ResourceWithConfigure = &jaasControllerResource{}
 var _ resource.ResourceWithImportState = &jaasControllerResource{}
 var _ resource.ResourceWithConfigValidators = &jaasControllerResource{}
 
+// Statuses JAAS reports for controllers, besides available.
+const (
+	controllerStatusDeprecated  = "deprecated"
+	controllerStatusUnavailable = "unavailable"
+)
+
 // NewJAASControllerResource returns a new instance of the JAAS controller resource.
 func NewJAASControllerResource() resource.Resource {
 	return &jaasControllerResource{}
@@ -51,6 +59,8 @@ type jaasControllerResourceModel struct {
 	Username      types.String `tfsdk:"username"`
 	Password      types.String `tfsdk:"password"`
 
+	Deprecated types.Bool `tfsdk:"deprecated"`
+
 	// Read-only fields returned by JAAS.
 	Status types.String `tfsdk:"status"`
 
@@ -69,7 +79,10 @@ func (r *jaasControllerResource) Metadata(_ context.Context, req resource.Metada
 // Schema defines the schema for the resource.
 func (r *jaasControllerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A resource that represents a controller registered in JAAS (JIMM).",
+		Description: "A resource that represents a controller registered in JAAS (JIMM). JIMM has no API to update" +
+			" the connection details of a registered controller, so changing the addresses, CA certificate," +
+			" TLS hostname, username or password removes the controller from JAAS and registers it again." +
+			" Only deprecated is updated in place.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Description: "Name of the controller to register.",
@@ -90,6 +103,7 @@ func (r *jaasControllerResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -138,13 +152,22 @@ func (r *jaasControllerResource) Schema(_ context.Context, _ resource.SchemaRequ
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"deprecated": schema.BoolAttribute{
+				Description: "Whether the controller is deprecated in JAAS. New models are not placed on deprecated controllers.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"status": schema.StringAttribute{
 				Description: "Status of the controller (available/deprecated/unavailable).",
 				Computed:    true,
 			},
 			"force": schema.BoolAttribute{
-				Description: "Force removal when deleting (only required when the controller is still available).",
-				Optional:    true,
+				Description: "Force removal when deleting. Required when the controller is still available" +
+					" or still hosts models, which are removed from JAAS along with the controller. The check for" +
+					" hosted models only sees the models visible to the JAAS user of the provider, JAAS" +
+					" administrators see every model.",
+				Optional: true,
 			},
 			"id": schema.StringAttribute{
 				Description:   "The ID of this resource.",
@@ -220,6 +243,14 @@ func (r *jaasControllerResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	if plan.Deprecated.ValueBool() {
+		info, err = r.client.Jaas.SetControllerDeprecated(ctx, info.Name, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deprecate JAAS controller %q, got error: %s", plan.Name.ValueString(), err))
+			return
+		}
+	}
+
 	state := plan
 	state.Name = types.StringValue(info.Name)
 	state.UUID = types.StringValue(info.UUID)
@@ -274,15 +305,51 @@ func (r *jaasControllerResource) Read(ctx context.Context, req resource.ReadRequ
 	// state.APIAddresses, _ = types.ListValueFrom(ctx, types.StringType, found.APIAddresses)
 	state.CACertificate = types.StringValue(found.CACertificate)
 	state.Status = types.StringValue(string(found.Status.Status))
+	// An unavailable controller does not report whether it is deprecated.
+	if found.Status.Status != controllerStatusUnavailable {
+		state.Deprecated = types.BoolValue(found.Status.Status == controllerStatusDeprecated)
+	}
 	// Do not overwrite sensitive fields (username/password) on Read.
 	state.ID = types.StringValue(found.Name)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
-// Update is not supported for this resource since all fields require replacement.
+// Update updates the deprecation of the controller in place. Changes to
+// the connection details require replacement, JAAS cannot update them.
 func (r *jaasControllerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Unsupported", "juju_jaas_controller does not support update; changes require replacement")
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "resource-jaas-controller", "update")
+		return
+	}
+
+	var plan, state jaasControllerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var info *params.ControllerInfo
+	if !plan.Deprecated.Equal(state.Deprecated) {
+		tflog.Trace(r.subCtx, "setting JAAS controller deprecation", map[string]interface{}{"deprecated": plan.Deprecated.ValueBool()})
+
+		updated, err := r.client.Jaas.SetControllerDeprecated(ctx, state.Name.ValueString(), plan.Deprecated.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set deprecation of JAAS controller %q, got error: %s", state.Name.ValueString(), err))
+			return
+		}
+		info = &updated
+	}
+
+	newState := plan
+	newState.ID = state.ID
+	newState.Status = state.Status
+	if info != nil {
+		newState.Status = types.StringValue(string(info.Status.Status))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
 
 // Delete removes the controller from JAAS.
@@ -298,6 +365,22 @@ func (r *jaasControllerResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	// JIMM only lists the models visible to the caller, the guard can miss
+	// models when the provider is not configured with a JAAS administrator.
+	if !state.Force.ValueBool() {
+		models, err := r.client.Jaas.ListControllerModels(ctx, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list models of JAAS controller %q, got error: %s", state.Name.ValueString(), err))
+			return
+		}
+		if len(models) > 0 {
+			resp.Diagnostics.AddError("Controller Still Hosts Models",
+				fmt.Sprintf("JAAS controller %q still hosts %d model(s): %s. Destroy or migrate the models first, or set force to true"+
+					" to remove the controller and its models from JAAS.", state.Name.ValueString(), len(models), strings.Join(models, ", ")))
+			return
+		}
+	}
+
 	_, err := r.client.Jaas.RemoveController(ctx, &params.RemoveControllerRequest{Name: state.Name.ValueString(), Force: state.Force.ValueBool()})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove JAAS controller %q, got error: %s", state.Name.ValueString(), err))
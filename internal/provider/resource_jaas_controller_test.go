@@ -5,9 +5,11 @@ package provider
 
 import (
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 // The test steps are defined below to live close to the logic
@@ -29,12 +31,14 @@ func testJAASControllerResourceSteps(t *testing.T, resourceName, controllerName,
 			bootstrapConfig,
 			nil,
 			nil,
+			false,
 		),
 		Check: resource.ComposeTestCheckFunc(
 			resource.TestCheckResourceAttr("juju_jaas_controller.jaas", "name", controllerName),
 			resource.TestCheckResourceAttrPair("juju_jaas_controller.jaas", "uuid", resourceName, "controller_uuid"),
 			resource.TestCheckResourceAttrSet("juju_jaas_controller.jaas", "id"),
 			resource.TestCheckResourceAttrSet("juju_jaas_controller.jaas", "status"),
+			resource.TestCheckResourceAttr("juju_jaas_controller.jaas", "deprecated", "false"),
 			testAccCheckJaasControllerRegistered(t, controllerName, true),
 		),
 	},
@@ -52,6 +56,33 @@ func testJAASControllerResourceSteps(t *testing.T, resourceName, controllerName,
 				bootstrapConfig,
 				nil,
 				nil,
+				true,
+			),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction("juju_jaas_controller.jaas", plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("juju_jaas_controller.jaas", "deprecated", "true"),
+				resource.TestCheckResourceAttr("juju_jaas_controller.jaas", "status", "deprecated"),
+			),
+		},
+		{
+			SkipFunc: func() (bool, error) {
+				// Skip if not JAAS
+				if _, ok := os.LookupEnv("IS_JAAS"); !ok {
+					return true, nil
+				}
+				return false, nil
+			},
+			Config: testAccResourceControllerAndJAASRegistration(
+				controllerName,
+				agentVersion,
+				bootstrapConfig,
+				nil,
+				nil,
+				true,
 			),
 			ResourceName:      "juju_jaas_controller.jaas",
 			ImportState:       true,
@@ -60,7 +91,7 @@ func testJAASControllerResourceSteps(t *testing.T, resourceName, controllerName,
 	}
 }
 
-func testAccResourceControllerAndJAASRegistration(controllerName, agentVersion string, bootstrapConfig, controllerConfig, modelConfig map[string]string) string {
+func testAccResourceControllerAndJAASRegistration(controllerName, agentVersion string, bootstrapConfig, controllerConfig, modelConfig map[string]string, deprecated bool) string {
 	base := testAccResourceControllerWithJujuBinary(controllerName, agentVersion, bootstrapConfig, controllerConfig, modelConfig)
 	return base + `
 resource "juju_jaas_controller" "jaas" {
@@ -74,6 +105,7 @@ resource "juju_jaas_controller" "jaas" {
   password = juju_controller.controller.password
 
   tls_hostname = "juju-apiserver"
+  deprecated   = ` + strconv.FormatBool(deprecated) + `
 }
 `
 }
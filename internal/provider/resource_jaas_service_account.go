@@ -0,0 +1,207 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ resource.Resource = &jaasServiceAccountResource{}
+var _ resource.ResourceWithConfigure = &jaasServiceAccountResource{}
+var _ resource.ResourceWithConfigValidators = &jaasServiceAccountResource{}
+var _ resource.ResourceWithImportState = &jaasServiceAccountResource{}
+
+type jaasServiceAccountResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+// NewJAASServiceAccountResource returns a new instance of the JAAS service account resource.
+func NewJAASServiceAccountResource() resource.Resource {
+	return &jaasServiceAccountResource{}
+}
+
+type jaasServiceAccountResourceModel struct {
+	ClientID    types.String `tfsdk:"client_id"`
+	DisplayName types.String `tfsdk:"display_name"`
+
+	// ID required for imports
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata returns the metadata for the JAAS service account resource.
+func (r *jaasServiceAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_service_account"
+}
+
+// ConfigValidators sets validators for the service account resource.
+func (r *jaasServiceAccountResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewResourceRequiresJAASValidator(r.client),
+	}
+}
+
+// Schema defines the schema for JAAS service accounts.
+func (r *jaasServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a service account in JAAS. The service account is" +
+			" administered by the user running terraform, and can be granted access with the" +
+			" `service_accounts` attribute of the `juju_jaas_access_*` resources.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "The client ID of the service account, without the @serviceaccount domain." +
+					" Changing this value will cause the service account to be destroyed and recreated by terraform.",
+				Required:   true,
+				Validators: serviceAccountIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "A human readable name for the service account. JAAS identifies service accounts" +
+					" by their client ID only, so the display name is kept in the Terraform state.",
+				Optional: true,
+			},
+			// ID required for imports
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the JAAS service account resource with the provider data.
+func (r *jaasServiceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderData(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = provider.Client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASServiceAccount)
+}
+
+// Create attempts to add the service account represented by the resource to JAAS.
+func (r *jaasServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccount, "create")
+		return
+	}
+
+	// Read Terraform configuration from the request into the model
+	var plan jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the service account to JAAS
+	clientID := plan.ClientID.ValueString()
+	if err := r.client.Jaas.AddServiceAccount(ctx, clientID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add service account %q, got error: %s", clientID, err))
+		return
+	}
+	r.trace(fmt.Sprintf("added service account %q", clientID))
+
+	plan.ID = plan.ClientID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read checks that the service account represented by the resource
+// still exists in JAAS.
+func (r *jaasServiceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccount, "read")
+		return
+	}
+
+	// Read the Terraform state from the request into the model
+	var state jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := state.ID.ValueString()
+	_, err := r.client.Jaas.ReadServiceAccount(ctx, clientID)
+	if errors.Is(err, errors.NotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get service account %q, got error: %s", clientID, err))
+		return
+	}
+
+	state.ClientID = types.StringValue(clientID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update only saves the display name, which is not stored by JAAS.
+func (r *jaasServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read the plan from the request into the model
+	var plan jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the service account from the Terraform state. JAAS
+// offers no way to remove a service account, so it is left in place
+// along with the access granted to it.
+func (r *jaasServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read the Terraform state from the request into the model
+	var state jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Service Account Not Removed",
+		fmt.Sprintf("JAAS does not support removing service accounts, %q has only been removed from the Terraform state. "+
+			"Revoke its access and credentials with the juju_jaas_access_* and juju_jaas_service_account_credential resources.",
+			state.ClientID.ValueString()),
+	)
+}
+
+// ImportState imports a service account using its client ID.
+func (r *jaasServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jaasServiceAccountResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceJAASServiceAccount, msg, additionalFields...)
+}
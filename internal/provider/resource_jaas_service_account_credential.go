@@ -0,0 +1,301 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ resource.Resource = &jaasServiceAccountCredentialResource{}
+var _ resource.ResourceWithConfigure = &jaasServiceAccountCredentialResource{}
+var _ resource.ResourceWithConfigValidators = &jaasServiceAccountCredentialResource{}
+var _ resource.ResourceWithImportState = &jaasServiceAccountCredentialResource{}
+
+type jaasServiceAccountCredentialResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+// NewJAASServiceAccountCredentialResource returns a new instance of the JAAS
+// service account credential resource.
+func NewJAASServiceAccountCredentialResource() resource.Resource {
+	return &jaasServiceAccountCredentialResource{}
+}
+
+type jaasServiceAccountCredentialResourceModel struct {
+	ServiceAccount types.String `tfsdk:"service_account"`
+	Cloud          types.String `tfsdk:"cloud"`
+	Name           types.String `tfsdk:"name"`
+	AuthType       types.String `tfsdk:"auth_type"`
+	Attributes     types.Map    `tfsdk:"attributes"`
+
+	// ID required for imports
+	ID types.String `tfsdk:"id"`
+}
+
+func (m jaasServiceAccountCredentialResourceModel) input() juju.ServiceAccountCredentialInput {
+	return juju.ServiceAccountCredentialInput{
+		ClientID:  m.ServiceAccount.ValueString(),
+		CloudName: m.Cloud.ValueString(),
+		Name:      m.Name.ValueString(),
+		AuthType:  m.AuthType.ValueString(),
+	}
+}
+
+// Metadata returns the metadata for the JAAS service account credential resource.
+func (r *jaasServiceAccountCredentialResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_service_account_credential"
+}
+
+// ConfigValidators sets validators for the service account credential resource.
+func (r *jaasServiceAccountCredentialResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewResourceRequiresJAASValidator(r.client),
+	}
+}
+
+// Schema defines the schema for JAAS service account credentials.
+func (r *jaasServiceAccountCredentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a cloud credential owned by a JAAS service account. " +
+			"The service account can use the credential to add models to the cloud.",
+		Attributes: map[string]schema.Attribute{
+			"service_account": schema.StringAttribute{
+				Description: "The client ID of the service account owning the credential, without the @serviceaccount" +
+					" domain. Changing this value will cause the credential to be destroyed and recreated by terraform.",
+				Required:   true,
+				Validators: serviceAccountIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud": schema.StringAttribute{
+				Description: "The name of the cloud of the credential. Changing this value will cause the" +
+					" credential to be destroyed and recreated by terraform.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the credential. Changing this value will cause the credential to be" +
+					" destroyed and recreated by terraform.",
+				Required: true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidCloudCredentialName, "must be a valid credential name"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_type": schema.StringAttribute{
+				Description: "Credential authorization type.",
+				Required:    true,
+			},
+			"attributes": schema.MapAttribute{
+				Description: "Credential attributes accordingly to the cloud. JAAS does not return them, so" +
+					" changes made outside of terraform are not detected.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			// ID required for imports
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the JAAS service account credential resource with the provider data.
+func (r *jaasServiceAccountCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderData(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = provider.Client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASServiceAccountCredential)
+}
+
+// Create uploads the credential on behalf of the service account.
+func (r *jaasServiceAccountCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccountCredential, "create")
+		return
+	}
+
+	// Read Terraform configuration from the request into the model
+	var plan jaasServiceAccountCredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.updateCredential(ctx, plan, &resp.Diagnostics) {
+		return
+	}
+
+	plan.ID = types.StringValue(newServiceAccountCredentialID(plan))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read checks that the credential is still owned by the service account.
+func (r *jaasServiceAccountCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccountCredential, "read")
+		return
+	}
+
+	// Read the Terraform state from the request into the model
+	var state jaasServiceAccountCredentialResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID, cloud, name, err := retrieveServiceAccountCredentialDataFromID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Malformed ID", err.Error())
+		return
+	}
+	state.ServiceAccount = types.StringValue(clientID)
+	state.Cloud = types.StringValue(cloud)
+	state.Name = types.StringValue(name)
+
+	credential, err := r.client.Jaas.ReadServiceAccountCredential(ctx, state.input())
+	if errors.Is(err, errors.NotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential %q of service account %q, got error: %s", name, clientID, err))
+		return
+	}
+	state.AuthType = types.StringValue(credential.AuthType)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update uploads the credential again with its new auth type or attributes.
+func (r *jaasServiceAccountCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccountCredential, "update")
+		return
+	}
+
+	// Read the plan from the request into the model
+	var plan jaasServiceAccountCredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.updateCredential(ctx, plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete revokes the credential of the service account.
+func (r *jaasServiceAccountCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccountCredential, "delete")
+		return
+	}
+
+	// Read the Terraform state from the request into the model
+	var state jaasServiceAccountCredentialResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Jaas.DestroyServiceAccountCredential(ctx, state.input()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove credential %q of service account %q, got error: %s",
+			state.Name.ValueString(), state.ServiceAccount.ValueString(), err))
+	}
+}
+
+// ImportState imports a service account credential using the format
+// `<client-id>:<cloud>:<name>`.
+func (r *jaasServiceAccountCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, _, _, err := retrieveServiceAccountCredentialDataFromID(req.ID); err != nil {
+		resp.Diagnostics.AddError("ImportState Failure", err.Error())
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateCredential uploads the planned credential, returning false on
+// failure.
+func (r *jaasServiceAccountCredentialResource) updateCredential(ctx context.Context, plan jaasServiceAccountCredentialResourceModel, diags *diag.Diagnostics) bool {
+	input := plan.input()
+	if !plan.Attributes.IsNull() {
+		input.Attributes = make(map[string]string)
+		diags.Append(plan.Attributes.ElementsAs(ctx, &input.Attributes, false)...)
+		if diags.HasError() {
+			return false
+		}
+	}
+	if err := r.client.Jaas.UpdateServiceAccountCredential(ctx, input); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update credential %q of service account %q, got error: %s",
+			input.Name, input.ClientID, err))
+		return false
+	}
+	r.trace(fmt.Sprintf("updated credential %q of service account %q", input.Name, input.ClientID))
+	return true
+}
+
+func (r *jaasServiceAccountCredentialResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceJAASServiceAccountCredential, msg, additionalFields...)
+}
+
+func newServiceAccountCredentialID(model jaasServiceAccountCredentialResourceModel) string {
+	return fmt.Sprintf("%s:%s:%s", model.ServiceAccount.ValueString(), model.Cloud.ValueString(), model.Name.ValueString())
+}
+
+func retrieveServiceAccountCredentialDataFromID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("service account credential ID %q is malformed, "+
+			"please use the format '<client-id>:<cloud>:<name>'", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
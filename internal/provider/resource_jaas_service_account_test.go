@@ -0,0 +1,97 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_ResourceJaasServiceAccount(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	clientID := acctest.RandomWithPrefix("tf-svc-acc")
+	groupName := acctest.RandomWithPrefix("tf-jaas-group")
+
+	serviceAccountResourceName := "juju_jaas_service_account.test"
+	credentialResourceName := "juju_jaas_service_account_credential.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceJaasServiceAccount("invalid@domain", groupName, "Pipeline"),
+				ExpectError: regexp.MustCompile("service account should not contain an @ symbol"),
+			},
+			{
+				Config: testAccResourceJaasServiceAccount(clientID, groupName, "Pipeline"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serviceAccountResourceName, "client_id", clientID),
+					resource.TestCheckResourceAttr(serviceAccountResourceName, "display_name", "Pipeline"),
+					resource.TestCheckResourceAttr(serviceAccountResourceName, "id", clientID),
+					resource.TestCheckResourceAttr(credentialResourceName, "id", clientID+":localhost:ci"),
+					resource.TestCheckResourceAttr(credentialResourceName, "auth_type", "certificate"),
+					resource.TestCheckTypeSetElemAttr("juju_jaas_access_group.test", "service_accounts.*", clientID),
+				),
+			},
+			{
+				Config: testAccResourceJaasServiceAccount(clientID, groupName, "CI pipeline"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serviceAccountResourceName, "display_name", "CI pipeline"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"display_name"},
+				ResourceName:            serviceAccountResourceName,
+			},
+			{
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"attributes"},
+				ResourceName:            credentialResourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceJaasServiceAccount(clientID, groupName, displayName string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceJaasServiceAccount",
+		`
+resource "juju_jaas_service_account" "test" {
+  client_id    = "{{ .ClientID }}"
+  display_name = "{{ .DisplayName }}"
+}
+
+resource "juju_jaas_service_account_credential" "test" {
+  service_account = juju_jaas_service_account.test.client_id
+  cloud           = "localhost"
+  name            = "ci"
+  auth_type       = "certificate"
+}
+
+resource "juju_jaas_group" "test" {
+  name = "{{ .GroupName }}"
+}
+
+resource "juju_jaas_access_group" "test" {
+  group_id         = juju_jaas_group.test.uuid
+  access           = "member"
+  service_accounts = [juju_jaas_service_account.test.client_id]
+}
+`, internaltesting.TemplateData{
+			"ClientID":    clientID,
+			"GroupName":   groupName,
+			"DisplayName": displayName,
+		})
+}
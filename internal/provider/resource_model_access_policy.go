@@ -0,0 +1,469 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &modelAccessPolicyResource{}
+var _ resource.ResourceWithConfigure = &modelAccessPolicyResource{}
+var _ resource.ResourceWithImportState = &modelAccessPolicyResource{}
+var _ resource.ResourceWithConfigValidators = &modelAccessPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &modelAccessPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &modelAccessPolicyResource{}
+
+// NewModelAccessPolicyResource returns a model access policy resource.
+func NewModelAccessPolicyResource() resource.Resource {
+	return &modelAccessPolicyResource{}
+}
+
+type modelAccessPolicyResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type modelAccessPolicyResourceModel struct {
+	ModelUUID  types.String `tfsdk:"model_uuid"`
+	AdminUsers types.Set    `tfsdk:"admin_users"`
+	WriteUsers types.Set    `tfsdk:"write_users"`
+	ReadUsers  types.Set    `tfsdk:"read_users"`
+	Owner      types.String `tfsdk:"owner"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// levels returns the user sets of the model keyed by access level.
+func (m *modelAccessPolicyResourceModel) levels() map[string]*types.Set {
+	return map[string]*types.Set{
+		"admin": &m.AdminUsers,
+		"write": &m.WriteUsers,
+		"read":  &m.ReadUsers,
+	}
+}
+
+// Metadata implements resource.ResourceWithConfigure interface.
+func (r *modelAccessPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_access_policy"
+}
+
+// ConfigValidators implements resource.ResourceWithConfigValidators interface.
+func (r *modelAccessPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewAvoidJAASValidator(r.client, "juju_jaas_access_model"),
+	}
+}
+
+// Schema implements resource.ResourceWithConfigure interface.
+func (r *modelAccessPolicyResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	usersValidators := []validator.Set{
+		setvalidator.ValueStringsAre(ValidatorMatchString(names.IsValidUser, "must be a valid user name")),
+	}
+	resp.Schema = schema.Schema{
+		Description: "A resource that authoritatively manages the access of users to a Juju model." +
+			" Every user with access to the model, other than the model owner, must be listed at exactly" +
+			" one access level: access granted outside of terraform is revoked on the next apply. It must" +
+			" not be combined with juju_access_model resources for the same model.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The UUID of the model for access management. Changing this value will cause the" +
+					" model access policy to be destroyed and recreated by terraform.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+			},
+			"admin_users": schema.SetAttribute{
+				Description: "Set of users with admin access to the model.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  usersValidators,
+			},
+			"write_users": schema.SetAttribute{
+				Description: "Set of users with write access to the model.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  usersValidators,
+			},
+			"read_users": schema.SetAttribute{
+				Description: "Set of users with read access to the model.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  usersValidators,
+			},
+			"owner": schema.StringAttribute{
+				Description: "The owner of the model. The owner always has admin access and is not managed by this resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that no user is listed at more than one access
+// level.
+func (r *modelAccessPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config modelAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]string)
+	for _, access := range []string{"admin", "write", "read"} {
+		set := config.levels()[access]
+		if set.IsNull() || set.IsUnknown() {
+			continue
+		}
+		for _, element := range set.Elements() {
+			user, ok := element.(types.String)
+			if !ok || user.IsUnknown() || user.IsNull() {
+				continue
+			}
+			if other, ok := seen[user.ValueString()]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(access+"_users"),
+					"Conflicting Access Levels",
+					fmt.Sprintf("User %q is listed with both %s and %s access, a user can only have one access level.",
+						user.ValueString(), other, access),
+				)
+				continue
+			}
+			seen[user.ValueString()] = access
+		}
+	}
+}
+
+// ModifyPlan reports a model owner listed in the policy during planning,
+// rather than failing the apply. The check is skipped when the model or
+// its users are not known yet, in which case applyPolicy reports it.
+func (r *modelAccessPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying or when the provider is not configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan modelAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ModelUUID.IsUnknown() {
+		return
+	}
+
+	access, err := r.client.Models.ReadModelAccess(ctx, plan.ModelUUID.ValueString())
+	if err != nil {
+		r.trace(fmt.Sprintf("skipping model owner check, %s", err))
+		return
+	}
+	for level, set := range plan.levels() {
+		if set.IsNull() || set.IsUnknown() {
+			continue
+		}
+		for _, element := range set.Elements() {
+			user, ok := element.(types.String)
+			if ok && !user.IsUnknown() && user.ValueString() == access.Owner {
+				resp.Diagnostics.Append(modelOwnerListedError(level, access.Owner))
+			}
+		}
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined Resource type.
+func (r *modelAccessPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, diags := getProviderData(req, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = provider.Client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceModelAccessPolicy)
+}
+
+// Create revokes the access of the users not in the policy and grants
+// the policy's access levels.
+func (r *modelAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model access policy", "create")
+		return
+	}
+	var plan modelAccessPolicyResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner, dErr := r.applyPolicy(ctx, plan)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Owner = types.StringValue(owner)
+	plan.ID = plan.ModelUUID
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read sets the user sets from the access currently granted on the
+// model, so that out of band grants are planned for removal.
+func (r *modelAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model access policy", "read")
+		return
+	}
+	var state modelAccessPolicyResourceModel
+
+	// Get the Terraform state from the request into the state
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelUUID := state.ID.ValueString()
+	access, err := r.client.Models.ReadModelAccess(ctx, modelUUID)
+	if errors.Is(err, juju.ModelNotFoundError) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model access policy resource, got error: %s", err))
+		return
+	}
+
+	usersByLevel := make(map[string][]string)
+	for user, level := range access.Access {
+		usersByLevel[level] = append(usersByLevel[level], user)
+	}
+	for level, set := range state.levels() {
+		users := usersByLevel[level]
+		// Keep the configured form of a level without users, null and
+		// empty sets being equivalent.
+		if len(users) == 0 && (set.IsNull() || len(set.Elements()) == 0) {
+			continue
+		}
+		sort.Strings(users)
+		value, dErr := types.SetValueFrom(ctx, types.StringType, users)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		*set = value
+	}
+
+	state.ModelUUID = types.StringValue(modelUUID)
+	state.Owner = types.StringValue(access.Owner)
+
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update moves every user of the model to the access level of the policy.
+func (r *modelAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model access policy", "update")
+		return
+	}
+	var plan modelAccessPolicyResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner, dErr := r.applyPolicy(ctx, plan)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Owner = types.StringValue(owner)
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete revokes the access of the users managed by the policy.
+func (r *modelAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model access policy", "delete")
+		return
+	}
+	var state modelAccessPolicyResourceModel
+
+	// Get the Terraform state from the request into the state
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, dErr := modelAccessPolicyUsers(ctx, state)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelUUID := state.ModelUUID.ValueString()
+	access, err := r.client.Models.ReadModelAccess(ctx, modelUUID)
+	if errors.Is(err, juju.ModelNotFoundError) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete model access policy resource, got error: %s", err))
+		return
+	}
+
+	// Only revoke the access of the users still granted access.
+	current := make(map[string]string)
+	for user := range managed {
+		if level, ok := access.Access[user]; ok {
+			current[user] = level
+		}
+	}
+	err = r.client.Models.SetModelAccess(ctx, juju.SetModelAccessInput{
+		ModelUUID: modelUUID,
+		Current:   current,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete model access policy resource, got error: %s", err))
+	}
+}
+
+// ImportState imports a model access policy using the model UUID.
+func (r *modelAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !names.IsValidModel(req.ID) {
+		resp.Diagnostics.AddError(
+			"ImportState Failure",
+			fmt.Sprintf("Malformed model access policy ID %q, please use the model UUID", req.ID),
+		)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyPolicy grants and revokes access on the model to match the plan,
+// returning the model owner.
+func (r *modelAccessPolicyResource) applyPolicy(ctx context.Context, plan modelAccessPolicyResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	desired, dErr := modelAccessPolicyUsers(ctx, plan)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	modelUUID := plan.ModelUUID.ValueString()
+	access, err := r.client.Models.ReadModelAccess(ctx, modelUUID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read access of model %q, got error: %s", modelUUID, err))
+		return "", diags
+	}
+	// Checked again in case the users were unknown during planning.
+	if level, ok := desired[access.Owner]; ok {
+		diags.Append(modelOwnerListedError(level, access.Owner))
+		return "", diags
+	}
+
+	err = r.client.Models.SetModelAccess(ctx, juju.SetModelAccessInput{
+		ModelUUID: modelUUID,
+		Current:   access.Access,
+		Desired:   desired,
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update access of model %q, got error: %s", modelUUID, err))
+		return "", diags
+	}
+	r.trace(fmt.Sprintf("applied access policy of model %q", modelUUID))
+
+	return access.Owner, diags
+}
+
+func (r *modelAccessPolicyResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceModelAccessPolicy, msg, additionalFields...)
+}
+
+// modelOwnerListedError returns the error reported when the model owner
+// is listed at the given access level of the policy.
+func modelOwnerListedError(level, owner string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root(level+"_users"),
+		"Model Owner Listed",
+		fmt.Sprintf("User %q owns the model and always has admin access, remove it from the policy.", owner),
+	)
+}
+
+// modelAccessPolicyUsers returns the access level of every user in the
+// model access policy.
+func modelAccessPolicyUsers(ctx context.Context, model modelAccessPolicyResourceModel) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	access := make(map[string]string)
+	for level, set := range model.levels() {
+		if set.IsNull() {
+			continue
+		}
+		var users []string
+		diags.Append(set.ElementsAs(ctx, &users, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for _, user := range users {
+			if other, ok := access[user]; ok {
+				diags.AddError("Conflicting Access Levels",
+					fmt.Sprintf("User %q is listed with both %s and %s access.", user, other, level))
+				return nil, diags
+			}
+			access[user] = level
+		}
+	}
+	return access, diags
+}
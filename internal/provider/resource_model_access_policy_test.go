@@ -0,0 +1,121 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_ResourceModelAccessPolicy(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	userName2 := acctest.RandomWithPrefix("tfuser")
+	userPassword2 := acctest.RandomWithPrefix("tf-test-user")
+	modelName := acctest.RandomWithPrefix("tf-model-access-policy")
+
+	resourceName := "juju_model_access_policy.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceModelAccessPolicy(userName, userPassword, userName2, userPassword2, modelName, "admin_users"),
+				ExpectError: regexp.MustCompile("Conflicting Access Levels"),
+			},
+			{
+				Config: testAccResourceModelAccessPolicy(userName, userPassword, userName2, userPassword2, modelName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "model_uuid", "juju_model."+modelName, "uuid"),
+					resource.TestCheckResourceAttr(resourceName, "write_users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "write_users.*", userName),
+					resource.TestCheckNoResourceAttr(resourceName, "read_users"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+				),
+			},
+			{
+				Config: testAccResourceModelAccessPolicy(userName, userPassword, userName2, userPassword2, modelName, "read_users"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "write_users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "read_users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "read_users.*", userName2),
+				),
+			},
+			{
+				// The model exists, so listing its owner fails during planning.
+				Config:      testAccResourceModelAccessPolicy(userName, userPassword, userName2, userPassword2, modelName, "owner"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Model Owner Listed"),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceModelAccessPolicy_ErrorWhenUsedWithJAAS(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "juju_model_access_policy" "test" {
+  model_uuid  = "0b7f5e1e-5b8a-4bb6-8d5c-9e2f4d3c2a11"
+  write_users = ["bob"]
+}`,
+				ExpectError: regexp.MustCompile("This resource is not supported with JAAS"),
+			},
+		},
+	})
+}
+
+// testAccResourceModelAccessPolicy grants write access to the first user
+// and, when secondUserSet is not empty, lists the second user in that set.
+// A secondUserSet of "owner" lists the model owner with admin access.
+func testAccResourceModelAccessPolicy(userName, userPassword, userName2, userPassword2, modelName, secondUserSet string) string {
+	return internaltesting.GetStringFromTemplateWithData("testAccResourceModelAccessPolicy", `
+resource "juju_user" "one" {
+  name     = "{{.UserName}}"
+  password = "{{.UserPassword}}"
+}
+
+resource "juju_user" "two" {
+  name     = "{{.UserName2}}"
+  password = "{{.UserPassword2}}"
+}
+
+resource "juju_model" "{{.ModelName}}" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_model_access_policy" "test" {
+  model_uuid  = juju_model.{{.ModelName}}.uuid
+  write_users = [juju_user.one.name]
+  {{- if eq .SecondUserSet "owner" }}
+  admin_users = ["admin"]
+  {{- else if .SecondUserSet }}
+  {{.SecondUserSet}} = [{{if eq .SecondUserSet "admin_users"}}juju_user.one.name, {{end}}juju_user.two.name]
+  {{- end }}
+}
+`, internaltesting.TemplateData{
+		"UserName":      userName,
+		"UserPassword":  userPassword,
+		"UserName2":     userName2,
+		"UserPassword2": userPassword2,
+		"ModelName":     modelName,
+		"SecondUserSet": secondUserSet,
+	})
+}
@@ -6,18 +6,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -34,6 +36,7 @@ var _ resource.Resource = &offerResource{}
 var _ resource.ResourceWithConfigure = &offerResource{}
 var _ resource.ResourceWithImportState = &offerResource{}
 var _ resource.ResourceWithIdentity = &offerResource{}
+var _ resource.ResourceWithModifyPlan = &offerResource{}
 
 // NewOfferResource returns an offer resource.
 func NewOfferResource() resource.Resource {
@@ -59,10 +62,36 @@ type offerResourceModelV2 struct {
 	offerResourceModel
 	ModelUUID         types.String   `tfsdk:"model_uuid"`
 	Endpoints         types.Set      `tfsdk:"endpoints"`
+	Description       types.String   `tfsdk:"description"`
+	Connections       types.List     `tfsdk:"connections"`
 	AllowForceDestroy types.Bool     `tfsdk:"allow_force_destroy"`
 	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
+// offerConnectionType is the type of the elements of the connections
+// attribute.
+var offerConnectionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"consumer_model_uuid": types.StringType,
+		"username":            types.StringType,
+		"relation_id":         types.Int64Type,
+		"endpoint":            types.StringType,
+		"status":              types.StringType,
+		"message":             types.StringType,
+		"ingress_subnets":     types.ListType{ElemType: types.StringType},
+	},
+}
+
+type offerConnectionModel struct {
+	ConsumerModelUUID types.String `tfsdk:"consumer_model_uuid"`
+	Username          types.String `tfsdk:"username"`
+	RelationID        types.Int64  `tfsdk:"relation_id"`
+	Endpoint          types.String `tfsdk:"endpoint"`
+	Status            types.String `tfsdk:"status"`
+	Message           types.String `tfsdk:"message"`
+	IngressSubnets    types.List   `tfsdk:"ingress_subnets"`
+}
+
 type offerResourceIdentityModel struct {
 	ID types.String `tfsdk:"id"`
 }
@@ -126,13 +155,61 @@ func (o *offerResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"endpoints": schema.SetAttribute{
 				ElementType: types.StringType,
-				Description: "The endpoint names. Changing this value will cause the offer" +
-					" to be destroyed and recreated by terraform.",
-				PlanModifiers: []planmodifier.Set{
-					setplanmodifier.RequiresReplace(),
-				},
+				Description: "The endpoint names. Endpoints can be added in place when the controller supports" +
+					" updating offers (Juju 3). Removing endpoints, or any change on controllers which do not" +
+					" support updating offers, will cause the offer to be destroyed and recreated by terraform.",
 				Required: true,
 			},
+			"description": schema.StringAttribute{
+				Description: "The description of the offer. Defaults to the description of the charm. Changing" +
+					" this value updates the offer in place when the controller supports updating offers (Juju 3)," +
+					" otherwise the offer is destroyed and recreated by terraform.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connections": schema.ListNestedAttribute{
+				Description: "The integrations made with the offer by consuming models.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"consumer_model_uuid": schema.StringAttribute{
+							Description: "The UUID of the consuming model.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The user consuming the offer.",
+							Computed:    true,
+						},
+						"relation_id": schema.Int64Attribute{
+							Description: "The ID of the integration in the offering model.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The offered endpoint used by the integration.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the integration.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The status message of the integration.",
+							Computed:    true,
+						},
+						"ingress_subnets": schema.ListAttribute{
+							Description: "The subnets from which the consumer connects.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"url": schema.StringAttribute{
 				Description: "The offer URL.",
 				Computed:    true,
@@ -208,6 +285,7 @@ func (o *offerResource) Create(ctx context.Context, req resource.CreateRequest,
 		ApplicationName: plan.ApplicationName.ValueString(),
 		Endpoints:       endpoints,
 		OfferOwner:      o.client.Username(),
+		Description:     plan.Description.ValueString(),
 	})
 	if errs != nil {
 		// TODO 10-Aug-2023
@@ -227,6 +305,29 @@ func (o *offerResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.URL = types.StringValue(response.OfferURL)
 	plan.ID = types.StringValue(response.OfferURL)
 
+	// Read the offer back to populate the description defaulted from the
+	// charm and the (empty) connections. The offer exists by then, a read
+	// failure only leaves the description to the next refresh.
+	var offerConnections []juju.OfferConnection
+	offer, err := o.client.Offers.ReadOffer(ctx, &juju.ReadOfferInput{
+		OfferURL: response.OfferURL,
+	})
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to read offer after creation, got error: %s", err))
+		if plan.Description.IsUnknown() {
+			plan.Description = types.StringValue("")
+		}
+	} else {
+		plan.Description = types.StringValue(offer.Description)
+		offerConnections = offer.Connections
+	}
+	connections, dErr := offerConnectionsValue(ctx, offerConnections)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Connections = connections
+
 	// Set the plan onto the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
@@ -269,6 +370,13 @@ func (o *offerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 	state.Endpoints = endpointSet
+	state.Description = types.StringValue(response.Description)
+	connections, dErr := offerConnectionsValue(ctx, response.Connections)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Connections = connections
 	state.URL = types.StringValue(response.OfferURL)
 	state.ID = types.StringValue(response.OfferURL)
 
@@ -281,17 +389,111 @@ func (o *offerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (o *offerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan offerResourceModelV2
+	// Prevent panic if the provider has not been configured.
+	if o.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "offer", "update")
+		return
+	}
+
+	var plan, state offerResourceModelV2
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Only allow_force_destroy and timeouts can change without triggering
-	// a replace, so just persist the new plan values to state.
+	// Endpoint additions and description changes are applied in place,
+	// ModifyPlan requires a replace for any other change to the offer.
+	if !plan.Endpoints.Equal(state.Endpoints) || !plan.Description.Equal(state.Description) {
+		var endpoints []string
+		resp.Diagnostics.Append(plan.Endpoints.ElementsAs(ctx, &endpoints, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := o.client.Offers.UpdateOffer(ctx, &juju.UpdateOfferInput{
+			ModelUUID:       plan.ModelUUID.ValueString(),
+			Name:            plan.OfferName.ValueString(),
+			ApplicationName: plan.ApplicationName.ValueString(),
+			Endpoints:       endpoints,
+			OfferOwner:      o.client.Username(),
+			Description:     plan.Description.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update offer, got error: %s", err))
+			return
+		}
+		o.trace(fmt.Sprintf("updated offer %q", plan.URL.ValueString()), map[string]interface{}{
+			"endpoints":   endpoints,
+			"description": plan.Description.ValueString(),
+		})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// ModifyPlan requires the offer to be replaced when endpoints are removed,
+// or when the endpoints or description change and the controller does not
+// support updating offers in place.
+func (o *offerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state offerResourceModelV2
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointsChanged := !plan.Endpoints.Equal(state.Endpoints)
+	descriptionChanged := !plan.Description.IsUnknown() && !plan.Description.Equal(state.Description)
+	if !endpointsChanged && !descriptionChanged {
+		return
+	}
+
+	var changed []path.Path
+	if endpointsChanged {
+		changed = append(changed, path.Root("endpoints"))
+	}
+	if descriptionChanged {
+		changed = append(changed, path.Root("description"))
+	}
+
+	if endpointsChanged {
+		if plan.Endpoints.IsUnknown() {
+			resp.RequiresReplace = append(resp.RequiresReplace, changed...)
+			return
+		}
+		var planned, current []string
+		resp.Diagnostics.Append(plan.Endpoints.ElementsAs(ctx, &planned, false)...)
+		resp.Diagnostics.Append(state.Endpoints.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, endpoint := range current {
+			if !slices.Contains(planned, endpoint) {
+				resp.RequiresReplace = append(resp.RequiresReplace, changed...)
+				return
+			}
+		}
+	}
+
+	if o.client == nil {
+		return
+	}
+	supported, err := o.client.Offers.SupportsOfferUpdate(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check whether the offer can be updated, got error: %s", err))
+		return
+	}
+	if !supported {
+		o.trace("controller does not support updating offers, the offer will be replaced")
+		resp.RequiresReplace = append(resp.RequiresReplace, changed...)
+	}
+}
+
 // Delete is called when the provider must delete the resource. Config
 // values may be read from the DeleteRequest.
 //
@@ -397,6 +599,28 @@ func (o *offerResource) trace(msg string, additionalFields ...map[string]interfa
 	tflog.SubsystemTrace(o.subCtx, LogResourceOffer, msg, additionalFields...)
 }
 
+// offerConnectionsValue converts the connections of an offer into the
+// value of the connections attribute.
+func offerConnectionsValue(ctx context.Context, connections []juju.OfferConnection) (types.List, diag.Diagnostics) {
+	models := make([]offerConnectionModel, 0, len(connections))
+	for _, conn := range connections {
+		subnets, diags := types.ListValueFrom(ctx, types.StringType, conn.IngressSubnets)
+		if diags.HasError() {
+			return types.ListNull(offerConnectionType), diags
+		}
+		models = append(models, offerConnectionModel{
+			ConsumerModelUUID: types.StringValue(conn.SourceModelUUID),
+			Username:          types.StringValue(conn.Username),
+			RelationID:        types.Int64Value(int64(conn.RelationID)),
+			Endpoint:          types.StringValue(conn.Endpoint),
+			Status:            types.StringValue(conn.Status),
+			Message:           types.StringValue(conn.Message),
+			IngressSubnets:    subnets,
+		})
+	}
+	return types.ListValueFrom(ctx, offerConnectionType, models)
+}
+
 func isOfferNotFound(err error) bool {
 	return strings.Contains(err.Error(), "expected to find one result for url")
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/juju/juju/api/client/modelmanager"
 	"github.com/juju/names/v6"
@@ -183,6 +184,75 @@ resource "juju_offer" "this" {
 `, modelName)
 }
 
+func TestAcc_ResourceOfferUpdateInPlace(t *testing.T) {
+	SkipAgainstJuju4WithReason(t, "Juju 4 does not support updating offers")
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-offer-update")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOfferUpdate(modelName, `["sink"]`, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "description", "first"),
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoints.#", "1"),
+					resource.TestCheckResourceAttr("juju_offer.this", "connections.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceOfferUpdate(modelName, `["sink", "juju-info"]`, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("juju_offer.this", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "description", "second"),
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoints.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceOfferUpdate(modelName, `["juju-info"]`, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("juju_offer.this", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("juju_offer.this", "endpoints.#", "1"),
+			},
+		},
+	})
+}
+
+func testAccResourceOfferUpdate(modelName, endpoints, description string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "this" {
+	model_uuid = juju_model.this.uuid
+	name  = "this"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "this" {
+	model_uuid       = juju_model.this.uuid
+	application_name = juju_application.this.name
+	endpoints        = %s
+	description      = %q
+}
+`, modelName, endpoints, description)
+}
+
 func TestAcc_ResourceOfferMultipleEndpoints(t *testing.T) {
 	SkipAgainstJuju4WithReason(t, "See https://github.com/juju/juju/issues/22213")
 	if testingCloud != MicroK8sTesting {
@@ -5,10 +5,18 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -31,6 +39,7 @@ var _ resource.ResourceWithConfigure = &secretResource{}
 var _ resource.ResourceWithImportState = &secretResource{}
 var _ resource.ResourceWithIdentity = &secretResource{}
 var _ resource.ResourceWithConfigValidators = &secretResource{}
+var _ resource.ResourceWithModifyPlan = &secretResource{}
 
 // NewSecretResource returns a secret resource.
 func NewSecretResource() resource.Resource {
@@ -56,6 +65,13 @@ type secretResourceModel struct {
 	// ValueWOVersion triggers an update of the write-only ValueWO. Bump this
 	// whenever the underlying write-only value changes.
 	ValueWOVersion types.Int64 `tfsdk:"value_wo_version"`
+	// ValueBase64 holds base64 encoded values, stored by Juju as they are.
+	ValueBase64 types.Map `tfsdk:"value_base64"`
+	// ValueFiles maps keys to files whose content is the value of the key.
+	ValueFiles types.Map `tfsdk:"value_files"`
+	// ValueFilesSHA256 holds the SHA-256 of the content of each key in
+	// ValueFiles, so changes to the files are planned as updates.
+	ValueFilesSHA256 types.Map `tfsdk:"value_files_sha256"`
 	// SecretId is the ID of the secret to be updated or removed. This attribute is required for 'update' and 'remove' actions.
 	SecretId types.String `tfsdk:"secret_id"`
 	// SecretURI is the URI of the secret e.g. `secret:coj8mulh8b41e8nv6p90` as a convenience for users.
@@ -63,6 +79,14 @@ type secretResourceModel struct {
 
 	// Info is the description of the secret. This attribute is optional for all actions.
 	Info types.String `tfsdk:"info"`
+	// AutoPrune removes revisions of the secret once they are no longer in use.
+	AutoPrune types.Bool `tfsdk:"auto_prune"`
+	// PruneRevisionsOlderThan removes the revisions below this revision number.
+	PruneRevisionsOlderThan types.Int64 `tfsdk:"prune_revisions_older_than"`
+	// CurrentRevision is the latest revision of the secret.
+	CurrentRevision types.Int64 `tfsdk:"current_revision"`
+	// Revisions are the revisions of the secret still held by Juju.
+	Revisions types.List `tfsdk:"revisions"`
 	// ID is used during terraform import.
 	ID types.String `tfsdk:"id"`
 }
@@ -73,6 +97,22 @@ type secretResourceModelV1 struct {
 	ModelUUID types.String `tfsdk:"model_uuid"`
 }
 
+// secretRevisionType is the type of the elements of the revisions
+// attribute.
+var secretRevisionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"revision": types.Int64Type,
+		"created":  types.StringType,
+		"expires":  types.StringType,
+	},
+}
+
+type secretRevisionModel struct {
+	Revision types.Int64  `tfsdk:"revision"`
+	Created  types.String `tfsdk:"created"`
+	Expires  types.String `tfsdk:"expires"`
+}
+
 type secretResourceIdentityModel struct {
 	ID types.String `tfsdk:"id"`
 }
@@ -140,9 +180,13 @@ func (s *secretResource) ImportState(ctx context.Context, req resource.ImportSta
 			SecretId:  types.StringValue(readSecretOutput.SecretId),
 			SecretURI: types.StringValue(readSecretOutput.SecretURI),
 			ID:        types.StringValue(newSecretID(modelUUID, readSecretOutput.SecretId)),
+			AutoPrune: types.BoolValue(readSecretOutput.AutoPrune),
 			// value_wo is write-only and never read back; keep it a typed null
 			// map so framework type verification succeeds on import.
-			ValueWO: types.MapNull(types.StringType),
+			ValueWO:          types.MapNull(types.StringType),
+			ValueBase64:      types.MapNull(types.StringType),
+			ValueFiles:       types.MapNull(types.StringType),
+			ValueFilesSHA256: types.MapNull(types.StringType),
 		},
 	}
 
@@ -157,6 +201,11 @@ func (s *secretResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 	state.Value = secretValue
 
+	resp.Diagnostics.Append(setSecretRevisions(ctx, &state.secretResourceModel, readSecretOutput.CurrentRevision, readSecretOutput.Revisions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save state into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
@@ -174,11 +223,17 @@ func (s *secretResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // ConfigValidators implements [resource.ResourceWithConfigValidators]. It
-// enforces that exactly one of value or value_wo is supplied, and that
-// value_wo_version is set whenever value_wo is used.
+// enforces that the secret has content, that value and value_wo are not
+// both supplied, and that value_wo_version is set whenever value_wo is used.
 func (s *secretResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
-		resourcevalidator.ExactlyOneOf(
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("value"),
+			path.MatchRoot("value_wo"),
+			path.MatchRoot("value_base64"),
+			path.MatchRoot("value_files"),
+		),
+		resourcevalidator.Conflicting(
 			path.MatchRoot("value"),
 			path.MatchRoot("value_wo"),
 		),
@@ -232,6 +287,32 @@ func (s *secretResource) Schema(_ context.Context, req resource.SchemaRequest, r
 					" write-only secret value.",
 				Optional: true,
 			},
+			"value_base64": schema.MapAttribute{
+				Description: "The value map of the secret for binary content, each value being base64" +
+					" encoded. The values are stored by Juju as they are, which matches the key#base64" +
+					" convention of the juju CLI. Keys must not be repeated in value, value_wo or value_files.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(
+						ValidatorMatchString(isBase64, "must be base64 encoded"),
+					),
+				},
+			},
+			"value_files": schema.MapAttribute{
+				Description: "A map of secret keys to paths of files holding their value. The files are" +
+					" read at plan time and may hold binary content. Changes to the content of the files" +
+					" are detected through value_files_sha256. Keys must not be repeated in value," +
+					" value_wo or value_base64.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"value_files_sha256": schema.MapAttribute{
+				Description: "The SHA-256 checksum of the content of each key in value_files.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"secret_id": schema.StringAttribute{
 				Description: "The ID of the secret. E.g. coj8mulh8b41e8nv6p90",
 				Computed:    true,
@@ -250,6 +331,45 @@ func (s *secretResource) Schema(_ context.Context, req resource.SchemaRequest, r
 				Description: "The description of the secret.",
 				Optional:    true,
 			},
+			"auto_prune": schema.BoolAttribute{
+				Description: "Whether Juju removes revisions of the secret once they are no longer" +
+					" tracked by any consumer.",
+				Optional: true,
+			},
+			"prune_revisions_older_than": schema.Int64Attribute{
+				Description: "Remove the revisions of the secret with a revision number lower than" +
+					" this value whenever the secret is created or updated, including when only this value" +
+					" changes. The current revision is never removed.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"current_revision": schema.Int64Attribute{
+				Description: "The latest revision of the secret.",
+				Computed:    true,
+			},
+			"revisions": schema.ListNestedAttribute{
+				Description: "The revisions of the secret held by Juju, sorted by revision number.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"revision": schema.Int64Attribute{
+							Description: "The revision number.",
+							Computed:    true,
+						},
+						"created": schema.StringAttribute{
+							Description: "The time the revision was created, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"expires": schema.StringAttribute{
+							Description: "The time the revision expires, in RFC 3339 format. Empty if" +
+								" the revision does not expire.",
+							Computed: true,
+						},
+					},
+				},
+			},
 			"id": schema.StringAttribute{
 				Description: "The ID of the secret. Used for terraform import.",
 				Computed:    true,
@@ -308,9 +428,10 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 			Name:      plan.Name.ValueString(),
 			Value:     secretValue,
 			Info:      plan.Info.ValueString(),
+			AutoPrune: plan.AutoPrune.ValueBool(),
 		},
 	)
-	if err != nil {
+	if createSecretOutput.SecretId == "" {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add secret, got error: %s", err))
 		return
 	}
@@ -318,6 +439,20 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.SecretId = types.StringValue(createSecretOutput.SecretId)
 	plan.SecretURI = types.StringValue(createSecretOutput.SecretURI)
 	plan.ID = types.StringValue(newSecretID(plan.ModelUUID.ValueString(), plan.SecretId.ValueString()))
+
+	// The secret exists from here on, errors are reported once it is saved
+	// so that the next apply does not try to add it again.
+	var postCreateDiags diag.Diagnostics
+	if err != nil {
+		postCreateDiags.AddError("Client Error", fmt.Sprintf("Unable to enable auto pruning of secret, got error: %s", err))
+		plan.AutoPrune = types.BoolValue(false)
+	}
+	postCreateDiags.Append(s.pruneRevisions(ctx, plan)...)
+	if readDiags := s.readSecretRevisions(ctx, &plan); readDiags.HasError() {
+		postCreateDiags.Append(readDiags...)
+		plan.CurrentRevision = types.Int64Null()
+		plan.Revisions = types.ListNull(secretRevisionType)
+	}
 	s.trace(fmt.Sprintf("saving secret resource %q", plan.SecretId.ValueString()),
 		map[string]interface{}{
 			"secretID": plan.SecretId.ValueString(),
@@ -333,6 +468,7 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 		ID: plan.ID,
 	}
 	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	resp.Diagnostics.Append(postCreateDiags...)
 
 	s.trace(fmt.Sprintf("created secret resource %s", plan.SecretId))
 }
@@ -372,18 +508,20 @@ func (s *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if !state.Info.IsNull() {
 		state.Info = types.StringValue(readSecretOutput.Info)
 	}
+	if !state.AutoPrune.IsNull() {
+		state.AutoPrune = types.BoolValue(readSecretOutput.AutoPrune)
+	}
 	state.SecretURI = types.StringValue(readSecretOutput.SecretURI)
 	state.ID = types.StringValue(newSecretID(state.ModelUUID.ValueString(), readSecretOutput.SecretId))
 
-	// Only refresh value when the plain (non write-only) value attribute is in
-	// use. When value_wo is used, value is null in state and must stay null.
-	if !state.Value.IsNull() {
-		secretValue, errDiag := types.MapValueFrom(ctx, types.StringType, readSecretOutput.Value)
-		resp.Diagnostics.Append(errDiag...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		state.Value = secretValue
+	resp.Diagnostics.Append(s.refreshSecretValue(ctx, &state, readSecretOutput)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setSecretRevisions(ctx, &state.secretResourceModel, readSecretOutput.CurrentRevision, readSecretOutput.Revisions)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Save state into Terraform state
@@ -431,43 +569,37 @@ func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		updatedSecretInput.Name = plan.Name.ValueStringPointer()
 	}
 
-	// Check if the secret value has changed. The write-only value_wo cannot be
-	// compared directly (it is always null in state), so a change to
-	// value_wo_version triggers re-sending value_wo. Otherwise the plain value
-	// attribute is compared as before.
+	// Check if the secret content has changed. The write-only value_wo cannot
+	// be compared directly (it is always null in state), so a change to
+	// value_wo_version triggers re-sending value_wo. Files are compared by
+	// the checksum of their content. Juju replaces the whole content of a
+	// secret, so any change sends every key.
+	contentChanged := false
 	if !plan.ValueWOVersion.IsNull() {
-		// Switching to or already using value_wo: ensure value is nulled out in
-		// state so the framework sees a consistent null for the sensitive
-		// attribute (handles the value → value_wo transition).
-		state.Value = types.MapNull(types.StringType)
-		if !plan.ValueWOVersion.Equal(state.ValueWOVersion) {
-			noChange = false
-			state.ValueWOVersion = plan.ValueWOVersion
-
-			var valueWO map[string]string
-			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			updatedSecretInput.Value = &valueWO
-		}
+		contentChanged = !plan.ValueWOVersion.Equal(state.ValueWOVersion)
 	} else {
-		// Switching to or already using plain value: ensure value_wo_version is
-		// nulled out in state (handles the value_wo → value transition).
+		contentChanged = !plan.Value.Equal(state.Value)
+	}
+	if !plan.ValueBase64.Equal(state.ValueBase64) ||
+		!plan.ValueFiles.Equal(state.ValueFiles) ||
+		!plan.ValueFilesSHA256.Equal(state.ValueFilesSHA256) {
+		contentChanged = true
+	}
+	// Switching between value and value_wo leaves the unused attributes
+	// null in the plan, copying them keeps the state consistent.
+	state.Value = plan.Value
+	state.ValueWOVersion = plan.ValueWOVersion
+	state.ValueBase64 = plan.ValueBase64
+	state.ValueFiles = plan.ValueFiles
+	state.ValueFilesSHA256 = plan.ValueFilesSHA256
+	if contentChanged {
 		noChange = false
-		state.ValueWOVersion = types.Int64Null()
-
-		if !plan.Value.Equal(state.Value) {
-			noChange = false
-			resp.Diagnostics.Append(plan.Value.ElementsAs(ctx, &state.Value, false)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			resp.Diagnostics.Append(plan.Value.ElementsAs(ctx, &updatedSecretInput.Value, false)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
+		secretValue, diags := s.resolveSecretValue(ctx, plan, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+		updatedSecretInput.Value = &secretValue
 	}
 
 	// Check if the secret info has changed
@@ -477,13 +609,32 @@ func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		updatedSecretInput.Info = plan.Info.ValueStringPointer()
 	}
 
-	if noChange {
+	// Check if auto pruning has changed
+	if !plan.AutoPrune.Equal(state.AutoPrune) {
+		noChange = false
+		state.AutoPrune = plan.AutoPrune
+		autoPrune := plan.AutoPrune.ValueBool()
+		updatedSecretInput.AutoPrune = &autoPrune
+	}
+
+	if !noChange {
+		err = s.client.Secrets.UpdateSecret(ctx, &updatedSecretInput)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret, got error: %s", err))
+			return
+		}
+	}
+
+	// Pruning runs on every update, so that revisions created since the
+	// last apply below the threshold are removed too.
+	state.PruneRevisionsOlderThan = plan.PruneRevisionsOlderThan
+	resp.Diagnostics.Append(s.pruneRevisions(ctx, state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err = s.client.Secrets.UpdateSecret(ctx, &updatedSecretInput)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret, got error: %s", err))
+	resp.Diagnostics.Append(s.readSecretRevisions(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -530,9 +681,56 @@ func (s *secretResource) trace(msg string, additionalFields ...map[string]interf
 	tflog.SubsystemTrace(s.subCtx, LogResourceSecret, msg, additionalFields...)
 }
 
-// resolveSecretValue returns the secret value to send to Juju, reading from the
-// write-only value_wo attribute (via config) when value_wo_version is set, and
-// otherwise from the plain value attribute in the plan.
+// ModifyPlan implements [resource.ResourceWithModifyPlan]. It computes the
+// checksums of the files in value_files, so that a change to their content
+// is planned as an update of the secret.
+func (s *secretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var valueFiles types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("value_files"), &valueFiles)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checksums := types.MapNull(types.StringType)
+	switch {
+	case valueFiles.IsUnknown():
+		checksums = types.MapUnknown(types.StringType)
+	case !valueFiles.IsNull():
+		files := make(map[string]string)
+		resp.Diagnostics.Append(valueFiles.ElementsAs(ctx, &files, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		sums := make(map[string]string, len(files))
+		for key, file := range files {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("value_files").AtMapKey(key),
+					"Unable to read secret value file", err.Error())
+				continue
+			}
+			sums[key] = secretContentSHA256(content)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var diags diag.Diagnostics
+		checksums, diags = types.MapValueFrom(ctx, types.StringType, sums)
+		resp.Diagnostics.Append(diags...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value_files_sha256"), checksums)...)
+}
+
+// resolveSecretValue returns the secret value to send to Juju. The plain
+// values are read from the write-only value_wo attribute (via config) when
+// value_wo_version is set, and otherwise from the value attribute in the
+// plan. Binary values from value_base64 and value_files are added with the
+// juju.Base64Suffix so they are stored as they are.
 func (s *secretResource) resolveSecretValue(
 	ctx context.Context,
 	plan secretResourceModelV1,
@@ -543,13 +741,181 @@ func (s *secretResource) resolveSecretValue(
 
 	if !plan.ValueWOVersion.IsNull() {
 		diags.Append(config.GetAttribute(ctx, path.Root("value_wo"), &secretValue)...)
-		return secretValue, diags
+	} else if !plan.Value.IsNull() {
+		diags.Append(plan.Value.ElementsAs(ctx, &secretValue, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !plan.ValueBase64.IsNull() {
+		encoded := make(map[string]string)
+		diags.Append(plan.ValueBase64.ElementsAs(ctx, &encoded, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for key, value := range encoded {
+			secretValue[key+juju.Base64Suffix] = value
+		}
 	}
 
-	diags.Append(plan.Value.ElementsAs(ctx, &secretValue, false)...)
+	if !plan.ValueFiles.IsNull() {
+		files := make(map[string]string)
+		diags.Append(plan.ValueFiles.ElementsAs(ctx, &files, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for key, file := range files {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				diags.AddAttributeError(path.Root("value_files").AtMapKey(key),
+					"Unable to read secret value file", err.Error())
+				return nil, diags
+			}
+			secretValue[key+juju.Base64Suffix] = base64.StdEncoding.EncodeToString(content)
+		}
+	}
 	return secretValue, diags
 }
 
+// refreshSecretValue updates the content attributes of the state from the
+// secret read from Juju. Keys managed by value_base64 are read back in their
+// encoded form and keys managed by value_files as the checksum of their
+// content, every other key belongs to value. When value_wo is used, value is
+// null in state and must stay null.
+func (s *secretResource) refreshSecretValue(ctx context.Context, state *secretResourceModelV1, secret juju.ReadSecretOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	binaryKeys := make(map[string]bool)
+	if !state.ValueBase64.IsNull() {
+		encoded := make(map[string]string)
+		diags.Append(state.ValueBase64.ElementsAs(ctx, &encoded, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		refreshed := make(map[string]string, len(encoded))
+		for key := range encoded {
+			binaryKeys[key] = true
+			if value, ok := secret.EncodedValue[key]; ok {
+				refreshed[key] = value
+			}
+		}
+		var d diag.Diagnostics
+		state.ValueBase64, d = types.MapValueFrom(ctx, types.StringType, refreshed)
+		diags.Append(d...)
+	}
+
+	if !state.ValueFiles.IsNull() {
+		files := make(map[string]string)
+		diags.Append(state.ValueFiles.ElementsAs(ctx, &files, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		sums := make(map[string]string, len(files))
+		for key := range files {
+			binaryKeys[key] = true
+			value, ok := secret.EncodedValue[key]
+			if !ok {
+				continue
+			}
+			content, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to decode secret key %q, got error: %s", key, err))
+				return diags
+			}
+			sums[key] = secretContentSHA256(content)
+		}
+		var d diag.Diagnostics
+		state.ValueFilesSHA256, d = types.MapValueFrom(ctx, types.StringType, sums)
+		diags.Append(d...)
+	}
+
+	if !state.Value.IsNull() {
+		plain := make(map[string]string, len(secret.Value))
+		for key, value := range secret.Value {
+			if !binaryKeys[key] {
+				plain[key] = value
+			}
+		}
+		var d diag.Diagnostics
+		state.Value, d = types.MapValueFrom(ctx, types.StringType, plain)
+		diags.Append(d...)
+	}
+	return diags
+}
+
+// secretContentSHA256 returns the hex encoded SHA-256 checksum of the
+// content of a secret value.
+func secretContentSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// isBase64 returns true if the value is standard, padded base64.
+func isBase64(value string) bool {
+	_, err := base64.StdEncoding.DecodeString(value)
+	return err == nil
+}
+
+// pruneRevisions removes the revisions of the secret below the configured
+// prune_revisions_older_than, if any.
+func (s *secretResource) pruneRevisions(ctx context.Context, model secretResourceModelV1) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if model.PruneRevisionsOlderThan.IsNull() {
+		return diags
+	}
+	removed, err := s.client.Secrets.PruneSecretRevisions(ctx, &juju.PruneSecretRevisionsInput{
+		SecretId:  model.SecretId.ValueString(),
+		ModelUUID: model.ModelUUID.ValueString(),
+		OlderThan: int(model.PruneRevisionsOlderThan.ValueInt64()),
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to prune secret revisions, got error: %s", err))
+		return diags
+	}
+	s.trace(fmt.Sprintf("pruned revisions %v of secret %s", removed, model.SecretId))
+	return diags
+}
+
+// readSecretRevisions reads the secret back from Juju to fill in the
+// current revision and the revision history of the model.
+func (s *secretResource) readSecretRevisions(ctx context.Context, model *secretResourceModelV1) diag.Diagnostics {
+	var diags diag.Diagnostics
+	readSecretOutput, err := s.client.Secrets.ReadSecret(ctx, &juju.ReadSecretInput{
+		SecretId:  model.SecretId.ValueString(),
+		ModelUUID: model.ModelUUID.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read secret revisions, got error: %s", err))
+		return diags
+	}
+	return setSecretRevisions(ctx, &model.secretResourceModel, readSecretOutput.CurrentRevision, readSecretOutput.Revisions)
+}
+
+// setSecretRevisions sets the current_revision and revisions attributes of
+// the model.
+func setSecretRevisions(ctx context.Context, model *secretResourceModel, current int, revisions []juju.SecretRevision) diag.Diagnostics {
+	models := make([]secretRevisionModel, 0, len(revisions))
+	for _, rev := range revisions {
+		expires := ""
+		if rev.Expires != nil {
+			expires = rev.Expires.Format(time.RFC3339)
+		}
+		models = append(models, secretRevisionModel{
+			Revision: types.Int64Value(int64(rev.Revision)),
+			Created:  types.StringValue(rev.Created.Format(time.RFC3339)),
+			Expires:  types.StringValue(expires),
+		})
+	}
+	value, diags := types.ListValueFrom(ctx, secretRevisionType, models)
+	if diags.HasError() {
+		return diags
+	}
+	model.CurrentRevision = types.Int64Value(int64(current))
+	model.Revisions = value
+	return diags
+}
+
 func newSecretID(modelUUID, secret string) string {
 	return fmt.Sprintf("%s:%s", modelUUID, secret)
 }
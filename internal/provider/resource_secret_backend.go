@@ -9,7 +9,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
@@ -29,6 +32,7 @@ var _ resource.Resource = &secretBackendResource{}
 var _ resource.ResourceWithConfigure = &secretBackendResource{}
 var _ resource.ResourceWithImportState = &secretBackendResource{}
 var _ resource.ResourceWithIdentity = &secretBackendResource{}
+var _ resource.ResourceWithValidateConfig = &secretBackendResource{}
 
 // NewSecretBackendResource returns a new instance of the secret backend resource.
 func NewSecretBackendResource() resource.Resource {
@@ -49,14 +53,67 @@ type secretBackendResourceModel struct {
 	// ConfigWO is the write-only backend configuration. Its content is never
 	// persisted to Terraform state; it is read from the configuration only.
 	ConfigWO types.Map `tfsdk:"config_wo"`
-	// ConfigWOVersion triggers an update of the write-only ConfigWO. Bump this
+	// ConfigWOVersion triggers an update of the write-only ConfigWO and of
+	// the write-only attributes of the Vault and Kubernetes blocks. Bump this
 	// whenever the underlying write-only value changes.
 	ConfigWOVersion types.Int64 `tfsdk:"config_wo_version"`
+	// Vault is the typed configuration of a vault backend.
+	Vault types.List `tfsdk:"vault"`
+	// Kubernetes is the typed configuration of a kubernetes backend.
+	Kubernetes types.List `tfsdk:"kubernetes"`
 
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
 
+// secretBackendVaultModel is the vault block of the secret backend
+// resource.
+type secretBackendVaultModel struct {
+	Endpoint  types.String `tfsdk:"endpoint"`
+	Namespace types.String `tfsdk:"namespace"`
+	MountPath types.String `tfsdk:"mount_path"`
+	CACert    types.String `tfsdk:"ca_cert"`
+	// TokenWO is the write-only Vault token handed to Juju.
+	TokenWO types.String `tfsdk:"token_wo"`
+	// RoleID and SecretIDWO authenticate with a Vault AppRole, the token
+	// issued by Vault is handed to Juju.
+	RoleID     types.String `tfsdk:"role_id"`
+	SecretIDWO types.String `tfsdk:"secret_id_wo"`
+}
+
+// secretBackendKubernetesModel is the kubernetes block of the secret
+// backend resource.
+type secretBackendKubernetesModel struct {
+	Endpoint       types.String `tfsdk:"endpoint"`
+	Namespace      types.String `tfsdk:"namespace"`
+	ServiceAccount types.String `tfsdk:"service_account"`
+	CACert         types.String `tfsdk:"ca_cert"`
+	// TokenWO is the write-only service account token handed to Juju.
+	TokenWO types.String `tfsdk:"token_wo"`
+}
+
+var secretBackendVaultType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"endpoint":     types.StringType,
+		"namespace":    types.StringType,
+		"mount_path":   types.StringType,
+		"ca_cert":      types.StringType,
+		"token_wo":     types.StringType,
+		"role_id":      types.StringType,
+		"secret_id_wo": types.StringType,
+	},
+}
+
+var secretBackendKubernetesType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"endpoint":        types.StringType,
+		"namespace":       types.StringType,
+		"service_account": types.StringType,
+		"ca_cert":         types.StringType,
+		"token_wo":        types.StringType,
+	},
+}
+
 type secretBackendResourceIdentityModel struct {
 	Name types.String `tfsdk:"name"`
 }
@@ -115,24 +172,189 @@ Secret backends store secret content. To learn more about secret backends, pleas
 			},
 			"token_rotate_interval": schema.StringAttribute{
 				Description: "The interval at which the backend's access credential/token should be rotated. " +
-					"Must be a duration string parsable by Go's time.ParseDuration (e.g., '10m', '1h', '24h').",
+					"Must be a duration string parsable by Go's time.ParseDuration (e.g., '1h', '24h')." +
+					" Vault backends require at least 1h, '0' disables rotation.",
 				Optional: true,
+				Validators: []validator.String{
+					ValidatorMatchString(isValidTokenRotateInterval, "must be a positive duration or 0, e.g. '0', '1h' or '24h'"),
+				},
 			},
 			"config_wo": schema.MapAttribute{
 				Description: "The write-only backend configuration. Its content is never persisted to" +
-					" Terraform state, because it can contain sensitive data. Requires config_wo_version to be set; bump config_wo_version to" +
-					" apply changes to this value.",
+					" Terraform state, because it can contain sensitive data. Bump config_wo_version to" +
+					" apply changes to this value. Exactly one of config_wo, vault or kubernetes must be set.",
 				ElementType: types.StringType,
-				Required:    true,
+				Optional:    true,
 				WriteOnly:   true,
 				Sensitive:   true,
 			},
 			"config_wo_version": schema.Int64Attribute{
-				Description: "The version of config_wo. Increment this value to trigger an update of the" +
+				Description: "The version of the write-only configuration, config_wo and the write-only attributes" +
+					" of the vault and kubernetes blocks. Increment this value to trigger an update of the" +
 					" write-only backend configuration.",
 				Required: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"vault": schema.ListNestedBlock{
+				Description: "The configuration of a vault secret backend. Requires backend_type to be 'vault'." +
+					" The backend is authenticated with either token_wo or an AppRole, with role_id and secret_id_wo.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Description: "The address of the Vault server, e.g. 'https://vault.example.com:8200'." +
+								" Changing this value will cause the backend to be destroyed and recreated by terraform.",
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"namespace": schema.StringAttribute{
+							Description: "The Vault namespace holding the secrets." +
+								" Changing this value will cause the backend to be destroyed and recreated by terraform.",
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"mount_path": schema.StringAttribute{
+							Description: "The path of the KV secrets engine used by Juju." +
+								" Changing this value will cause the backend to be destroyed and recreated by terraform.",
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"ca_cert": schema.StringAttribute{
+							Description: "The PEM encoded CA certificate of the Vault server.",
+							Optional:    true,
+						},
+						"token_wo": schema.StringAttribute{
+							Description: "The write-only Vault token used by Juju. Conflicts with role_id.",
+							Optional:    true,
+							WriteOnly:   true,
+							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("role_id")),
+							},
+						},
+						"role_id": schema.StringAttribute{
+							Description: "The role ID of the AppRole to log in to Vault with. The token issued" +
+								" by Vault is handed to Juju as is, Juju cannot log in again once it expires. Configure" +
+								" the AppRole with a token_period so that the issued token is periodic and renewed by Juju.",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_id_wo")),
+							},
+						},
+						"secret_id_wo": schema.StringAttribute{
+							Description: "The write-only secret ID of the AppRole to log in to Vault with.",
+							Optional:    true,
+							WriteOnly:   true,
+							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("role_id")),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"kubernetes": schema.ListNestedBlock{
+				Description: "The configuration of a kubernetes secret backend. Requires backend_type to be 'kubernetes'.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Description: "The address of the Kubernetes API server." +
+								" Changing this value will cause the backend to be destroyed and recreated by terraform.",
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"namespace": schema.StringAttribute{
+							Description: "The namespace in which to store secrets." +
+								" Changing this value will cause the backend to be destroyed and recreated by terraform.",
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"service_account": schema.StringAttribute{
+							Description: "The service account the token belongs to.",
+							Optional:    true,
+						},
+						"ca_cert": schema.StringAttribute{
+							Description: "The PEM encoded CA certificate of the Kubernetes API server.",
+							Optional:    true,
+						},
+						"token_wo": schema.StringAttribute{
+							Description: "The write-only service account token used by Juju.",
+							Optional:    true,
+							WriteOnly:   true,
+							Sensitive:   true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig implements [resource.ResourceWithValidateConfig]. It checks
+// the configuration comes from a single source matching the backend type.
+func (r *secretBackendResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config secretBackendResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Absent blocks are empty lists, unknown values are counted as set.
+	isSet := func(l types.List) bool {
+		return l.IsUnknown() || len(l.Elements()) > 0
+	}
+	sources := 0
+	if !config.ConfigWO.IsNull() {
+		sources++
+	}
+	if isSet(config.Vault) {
+		sources++
+	}
+	if isSet(config.Kubernetes) {
+		sources++
+	}
+	if sources != 1 {
+		resp.Diagnostics.AddError("Invalid Attribute Combination",
+			"Exactly one of config_wo, vault or kubernetes must be configured.")
+		return
+	}
+
+	if config.BackendType.IsUnknown() {
+		return
+	}
+	backendType := config.BackendType.ValueString()
+	if isSet(config.Vault) && backendType != juju.VaultBackendType {
+		resp.Diagnostics.AddAttributeError(path.Root("vault"), "Invalid Attribute Combination",
+			fmt.Sprintf("The vault block requires backend_type to be %q, got %q.", juju.VaultBackendType, backendType))
+	}
+	if isSet(config.Kubernetes) && backendType != juju.KubernetesBackendType {
+		resp.Diagnostics.AddAttributeError(path.Root("kubernetes"), "Invalid Attribute Combination",
+			fmt.Sprintf("The kubernetes block requires backend_type to be %q, got %q.", juju.KubernetesBackendType, backendType))
+	}
+
+	// Juju rotates vault tokens at most hourly, rotation is disabled with 0.
+	if backendType == juju.VaultBackendType && !config.TokenRotateInterval.IsNull() && !config.TokenRotateInterval.IsUnknown() {
+		d, err := time.ParseDuration(config.TokenRotateInterval.ValueString())
+		if err == nil && d > 0 && d < time.Hour {
+			resp.Diagnostics.AddAttributeError(path.Root("token_rotate_interval"), "Invalid Attribute Value",
+				fmt.Sprintf("The token_rotate_interval of a vault backend must be at least 1h or 0, got %q.", config.TokenRotateInterval.ValueString()))
+		}
 	}
 }
 
@@ -175,9 +397,22 @@ func (r *secretBackendResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 
+	getBackendResp, err := r.client.SecretBackends.GetSecretBackend(ctx, juju.GetSecretBackendInput{Name: name})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret backend %q, got error: %s", name, err))
+		return
+	}
+
 	state := secretBackendResourceModel{
-		Name:     types.StringValue(name),
-		ConfigWO: types.MapNull(types.StringType),
+		Name:        types.StringValue(name),
+		BackendType: types.StringValue(getBackendResp.Backend.BackendType),
+		ConfigWO:    types.MapNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	state.Vault, state.Kubernetes, diags = importSecretBackendBlocks(ctx, getBackendResp.Backend.BackendType, getBackendResp.Backend.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 	state.ID = generateSecretBackendResourceID(state)
 
@@ -206,6 +441,10 @@ func (r *secretBackendResource) Create(ctx context.Context, req resource.CreateR
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get secret backend config as map, got error: %s", err))
 		return
 	}
+	if err := r.client.SecretBackends.CheckSecretBackendReachable(ctx, plan.BackendType.ValueString(), configAny); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret backend resource, got error: %s", err))
+		return
+	}
 
 	var tokenRotateInterval *time.Duration
 	if !plan.TokenRotateInterval.IsNull() && !plan.TokenRotateInterval.IsUnknown() {
@@ -280,11 +519,21 @@ func (r *secretBackendResource) Read(ctx context.Context, req resource.ReadReque
 
 	state.Name = types.StringValue(getBackendResp.Backend.Name)
 	state.BackendType = types.StringValue(getBackendResp.Backend.BackendType)
-	if getBackendResp.Backend.TokenRotateInterval != nil {
-		state.TokenRotateInterval = types.StringValue(getBackendResp.Backend.TokenRotateInterval.String())
-	} else {
+	if interval := getBackendResp.Backend.TokenRotateInterval; interval != nil {
+		// Keep the configured spelling of the interval, e.g. "24h" rather
+		// than "24h0m0s", when it is the same duration.
+		current, err := time.ParseDuration(state.TokenRotateInterval.ValueString())
+		if err != nil || current != *interval {
+			state.TokenRotateInterval = types.StringValue(interval.String())
+		}
+	} else if current, err := time.ParseDuration(state.TokenRotateInterval.ValueString()); err != nil || current != 0 {
+		// Juju does not report a disabled rotation, keep a configured 0.
 		state.TokenRotateInterval = types.StringNull()
 	}
+	resp.Diagnostics.Append(refreshSecretBackendBlocks(ctx, &state, getBackendResp.Backend.Config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// config_wo is write-only and never read back; keep it a typed null.
 	state.ConfigWO = types.MapNull(types.StringType)
 
@@ -313,18 +562,25 @@ func (r *secretBackendResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	// Only send the write-only config to Juju when config_wo_version actually
-	// changed. This avoids silently re-applying whatever is currently in
-	// config_wo on unrelated updates (e.g. a rename), matching the documented
-	// contract that config changes require bumping config_wo_version.
+	// Only send the config to Juju when config_wo_version or the blocks
+	// actually changed. This avoids silently re-applying whatever is
+	// currently in the write-only attributes on unrelated updates (e.g. a
+	// rename), matching the documented contract that write-only changes
+	// require bumping config_wo_version.
 	var configAny map[string]any
-	if !plan.ConfigWOVersion.Equal(state.ConfigWOVersion) {
+	if !plan.ConfigWOVersion.Equal(state.ConfigWOVersion) ||
+		!plan.Vault.Equal(state.Vault) ||
+		!plan.Kubernetes.Equal(state.Kubernetes) {
 		var err error
 		configAny, err = r.resolveConfig(ctx, plan, req.Config)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get secret backend config as map, got error: %s", err))
 			return
 		}
+		if err := r.client.SecretBackends.CheckSecretBackendReachable(ctx, plan.BackendType.ValueString(), configAny); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret backend resource, got error: %s", err))
+			return
+		}
 	}
 
 	var tokenRotateInterval *time.Duration
@@ -404,13 +660,30 @@ func (r *secretBackendResource) trace(msg string, additionalFields ...map[string
 	tflog.SubsystemTrace(r.subCtx, LogResourceSecretBackend, msg, additionalFields...)
 }
 
-// resolveConfig returns the backend config to send to Juju, reading from the
-// write-only config_wo attribute (via config) when config_wo_version is set.
+// resolveConfig returns the backend config to send to Juju, built from the
+// vault or kubernetes block when one is set, or read from the write-only
+// config_wo attribute (via config) when config_wo_version is set. Write-only
+// attributes are only available in the configuration.
 func (r *secretBackendResource) resolveConfig(
 	ctx context.Context,
 	plan secretBackendResourceModel,
 	config tfsdk.Config,
 ) (map[string]any, error) {
+	if len(plan.Vault.Elements()) > 0 {
+		var vault []secretBackendVaultModel
+		if diags := config.GetAttribute(ctx, path.Root("vault"), &vault); diags.HasError() {
+			return nil, fmt.Errorf("failed to read vault: %v", diags)
+		}
+		return r.vaultConfig(ctx, vault[0])
+	}
+	if len(plan.Kubernetes.Elements()) > 0 {
+		var kubernetes []secretBackendKubernetesModel
+		if diags := config.GetAttribute(ctx, path.Root("kubernetes"), &kubernetes); diags.HasError() {
+			return nil, fmt.Errorf("failed to read kubernetes: %v", diags)
+		}
+		return kubernetesConfig(kubernetes[0]), nil
+	}
+
 	if !plan.ConfigWOVersion.IsNull() {
 		var configWO map[string]string
 		diags := config.GetAttribute(ctx, path.Root("config_wo"), &configWO)
@@ -427,6 +700,148 @@ func (r *secretBackendResource) resolveConfig(
 	return nil, nil
 }
 
+// vaultConfig returns the Juju configuration of a vault backend. With an
+// AppRole, the provider logs in to Vault and hands the issued token to Juju.
+func (r *secretBackendResource) vaultConfig(ctx context.Context, vault secretBackendVaultModel) (map[string]any, error) {
+	result := map[string]any{
+		"endpoint": vault.Endpoint.ValueString(),
+	}
+	setIfNotEmpty(result, "namespace", vault.Namespace)
+	setIfNotEmpty(result, "mount-path", vault.MountPath)
+	setIfNotEmpty(result, "ca-cert", vault.CACert)
+
+	token := vault.TokenWO.ValueString()
+	if !vault.RoleID.IsNull() {
+		var err error
+		token, err = r.client.SecretBackends.VaultAppRoleLogin(ctx, juju.VaultAppRoleLoginInput{
+			Endpoint:  vault.Endpoint.ValueString(),
+			Namespace: vault.Namespace.ValueString(),
+			CACert:    vault.CACert.ValueString(),
+			RoleID:    vault.RoleID.ValueString(),
+			SecretID:  vault.SecretIDWO.ValueString(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	result["token"] = token
+	return result, nil
+}
+
+// kubernetesConfig returns the Juju configuration of a kubernetes backend.
+func kubernetesConfig(kubernetes secretBackendKubernetesModel) map[string]any {
+	result := map[string]any{
+		"endpoint":  kubernetes.Endpoint.ValueString(),
+		"namespace": kubernetes.Namespace.ValueString(),
+	}
+	setIfNotEmpty(result, "service-account", kubernetes.ServiceAccount)
+	setIfNotEmpty(result, "ca-cert", kubernetes.CACert)
+	setIfNotEmpty(result, "token", kubernetes.TokenWO)
+	return result
+}
+
+func setIfNotEmpty(config map[string]any, key string, value types.String) {
+	if value.ValueString() != "" {
+		config[key] = value.ValueString()
+	}
+}
+
+// refreshSecretBackendBlocks updates the non write-only attributes of the
+// vault or kubernetes block in state from the backend configuration held
+// by Juju. Write-only attributes, and role_id which Juju never sees, are
+// kept as they are.
+func refreshSecretBackendBlocks(ctx context.Context, state *secretBackendResourceModel, config map[string]any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	configString := func(key string, current types.String) types.String {
+		value, _ := config[key].(string)
+		if value == "" && current.IsNull() {
+			return current
+		}
+		return types.StringValue(value)
+	}
+
+	if len(state.Vault.Elements()) > 0 {
+		var vault []secretBackendVaultModel
+		diags.Append(state.Vault.ElementsAs(ctx, &vault, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		vault[0].Endpoint = configString("endpoint", vault[0].Endpoint)
+		vault[0].Namespace = configString("namespace", vault[0].Namespace)
+		vault[0].MountPath = configString("mount-path", vault[0].MountPath)
+		vault[0].CACert = configString("ca-cert", vault[0].CACert)
+		var d diag.Diagnostics
+		state.Vault, d = types.ListValueFrom(ctx, secretBackendVaultType, vault)
+		diags.Append(d...)
+	}
+
+	if len(state.Kubernetes.Elements()) > 0 {
+		var kubernetes []secretBackendKubernetesModel
+		diags.Append(state.Kubernetes.ElementsAs(ctx, &kubernetes, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		kubernetes[0].Endpoint = configString("endpoint", kubernetes[0].Endpoint)
+		kubernetes[0].Namespace = configString("namespace", kubernetes[0].Namespace)
+		kubernetes[0].ServiceAccount = configString("service-account", kubernetes[0].ServiceAccount)
+		kubernetes[0].CACert = configString("ca-cert", kubernetes[0].CACert)
+		var d diag.Diagnostics
+		state.Kubernetes, d = types.ListValueFrom(ctx, secretBackendKubernetesType, kubernetes)
+		diags.Append(d...)
+	}
+	return diags
+}
+
+// importSecretBackendBlocks returns the vault and kubernetes blocks of an
+// imported backend, built from the backend configuration held by Juju. The
+// write-only attributes and role_id, which Juju never sees, are null.
+func importSecretBackendBlocks(ctx context.Context, backendType string, config map[string]any) (types.List, types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	vault := types.ListValueMust(secretBackendVaultType, []attr.Value{})
+	kubernetes := types.ListValueMust(secretBackendKubernetesType, []attr.Value{})
+	configString := func(key string) types.String {
+		value, _ := config[key].(string)
+		if value == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(value)
+	}
+
+	switch backendType {
+	case juju.VaultBackendType:
+		var d diag.Diagnostics
+		vault, d = types.ListValueFrom(ctx, secretBackendVaultType, []secretBackendVaultModel{{
+			Endpoint:   configString("endpoint"),
+			Namespace:  configString("namespace"),
+			MountPath:  configString("mount-path"),
+			CACert:     configString("ca-cert"),
+			TokenWO:    types.StringNull(),
+			RoleID:     types.StringNull(),
+			SecretIDWO: types.StringNull(),
+		}})
+		diags.Append(d...)
+	case juju.KubernetesBackendType:
+		var d diag.Diagnostics
+		kubernetes, d = types.ListValueFrom(ctx, secretBackendKubernetesType, []secretBackendKubernetesModel{{
+			Endpoint:       configString("endpoint"),
+			Namespace:      configString("namespace"),
+			ServiceAccount: configString("service-account"),
+			CACert:         configString("ca-cert"),
+			TokenWO:        types.StringNull(),
+		}})
+		diags.Append(d...)
+	}
+	return vault, kubernetes, diags
+}
+
+// isValidTokenRotateInterval returns true if the value is a duration Juju
+// accepts to rotate backend tokens, 0 disabling rotation. The minimum of
+// vault backends is checked by ValidateConfig.
+func isValidTokenRotateInterval(value string) bool {
+	d, err := time.ParseDuration(value)
+	return err == nil && d >= 0
+}
+
 func generateSecretBackendResourceID(plan secretBackendResourceModel) basetypes.StringValue {
 	return types.StringValue(plan.Name.ValueString())
 }
@@ -98,8 +98,10 @@ func TestAcc_ResourceSecretBackend(t *testing.T) {
 					rs := s.RootModule().Resources[resourceFullName]
 					return rs.Primary.Attributes["name"], nil
 				},
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"config_wo_version"},
+				ImportStateVerify: true,
+				// The configuration of an imported backend is read into the
+				// vault block, the backend was configured with config_wo.
+				ImportStateVerifyIgnore: []string{"config_wo_version", "vault"},
 			},
 		},
 	})
@@ -192,6 +194,170 @@ func TestAcc_ResourceSecretBackend_ConflictingConfig(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceSecretBackend_VaultBlock(t *testing.T) {
+	SkipJAAS(t)
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	vaultEndpoint := os.Getenv(TestVaultAddrEnvKey)
+	if vaultEndpoint == "" {
+		t.Skipf("%s is not set, skipping secret backend test", TestVaultAddrEnvKey)
+	}
+
+	backendName := acctest.RandomWithPrefix("test-backend")
+	resourceFullName := "juju_secret_backend." + backendName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretBackendVaultBlock(backendName, vaultEndpoint, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "backend_type", "vault"),
+					resource.TestCheckResourceAttr(resourceFullName, "vault.0.endpoint", vaultEndpoint),
+					resource.TestCheckNoResourceAttr(resourceFullName, "vault.0.token_wo"),
+					resource.TestCheckResourceAttr(resourceFullName, "token_rotate_interval", "24h"),
+				),
+			},
+			{
+				// Bumping config_wo_version sends the token again in place.
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceFullName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: testAccSecretBackendVaultBlock(backendName, vaultEndpoint, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "config_wo_version", "2"),
+				),
+			},
+			// The vault block is read from Juju on import, so the imported
+			// backend is not replaced.
+			{
+				ResourceName:            resourceFullName,
+				ImportState:             true,
+				ImportStateId:           backendName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config_wo_version"},
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceSecretBackend_ConfigValidation(t *testing.T) {
+	backendName := acctest.RandomWithPrefix("test-backend")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_secret_backend" "test" {
+  name              = %q
+  backend_type      = "vault"
+  config_wo_version = 1
+}
+`, backendName),
+				ExpectError: regexp.MustCompile("Exactly one of config_wo, vault or kubernetes must be configured"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_secret_backend" "test" {
+  name              = %q
+  backend_type      = "kubernetes"
+  config_wo_version = 1
+  vault {
+    endpoint = "https://vault.example.com:8200"
+    token_wo = "token"
+  }
+}
+`, backendName),
+				ExpectError: regexp.MustCompile(`The vault block requires backend_type to be "vault"`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_secret_backend" "test" {
+  name                  = %q
+  backend_type          = "vault"
+  config_wo_version     = 1
+  token_rotate_interval = "10m"
+  vault {
+    endpoint = "https://vault.example.com:8200"
+    token_wo = "token"
+  }
+}
+`, backendName),
+				ExpectError: regexp.MustCompile("must be at least 1h or 0"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_secret_backend" "test" {
+  name                  = %q
+  backend_type          = "vault"
+  config_wo_version     = 1
+  token_rotate_interval = "-1h"
+  vault {
+    endpoint = "https://vault.example.com:8200"
+    token_wo = "token"
+  }
+}
+`, backendName),
+				ExpectError: regexp.MustCompile("must be a positive duration or 0"),
+			},
+			// 0 disables rotation, and the 1h minimum only applies to vault.
+			{
+				Config: fmt.Sprintf(`
+resource "juju_secret_backend" "vault" {
+  name                  = %q
+  backend_type          = "vault"
+  config_wo_version     = 1
+  token_rotate_interval = "0"
+  vault {
+    endpoint = "https://vault.example.com:8200"
+    token_wo = "token"
+  }
+}
+
+resource "juju_secret_backend" "kubernetes" {
+  name                  = "%s-k8s"
+  backend_type          = "kubernetes"
+  config_wo_version     = 1
+  token_rotate_interval = "10m"
+  kubernetes {
+    endpoint  = "https://k8s.example.com:6443"
+    namespace = "secrets"
+  }
+}
+`, backendName, backendName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSecretBackendVaultBlock(backendName, endpoint string, configWOVersion int) string {
+	return internaltesting.GetStringFromTemplateWithData("testAccSecretBackendVaultBlock", `
+resource "juju_secret_backend" "{{.BackendName}}" {
+  name                  = "{{.BackendName}}"
+  backend_type          = "vault"
+  config_wo_version     = {{.ConfigWOVersion}}
+  token_rotate_interval = "24h"
+
+  vault {
+    endpoint = "{{.Endpoint}}"
+    token_wo = "myroot"
+  }
+}
+`, internaltesting.TemplateData{
+		"BackendName":     backendName,
+		"Endpoint":        endpoint,
+		"ConfigWOVersion": configWOVersion,
+	})
+}
+
 func testAccModelBlockConflictingSecretBackend(modelName string) string {
 	return internaltesting.GetStringFromTemplateWithData("testAccModelBlockConflictingSecretBackend", `
 resource "juju_model" "{{.ModelName}}" {
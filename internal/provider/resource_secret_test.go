@@ -4,13 +4,19 @@
 package provider
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
@@ -168,6 +174,116 @@ func TestAcc_ResourceSecret_Update(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceSecret_RevisionsAndPruning(t *testing.T) {
+	skipTestIfSecretsNotSupported(t)
+
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	secretName := "tf-test-secret"
+	resourceName := "juju_secret." + secretName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecretRevisions(modelName, secretName, "value1", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_prune", "false"),
+					resource.TestCheckResourceAttr(resourceName, "current_revision", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revisions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revisions.0.revision", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "revisions.0.created"),
+				),
+			},
+			{
+				Config: testAccResourceSecretRevisions(modelName, secretName, "value2", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "current_revision", "2"),
+					resource.TestCheckResourceAttr(resourceName, "revisions.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceSecretRevisions(modelName, secretName, "value3", 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "current_revision", "3"),
+					resource.TestCheckResourceAttr(resourceName, "revisions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revisions.0.revision", "3"),
+				),
+			},
+			{
+				Config: testAccResourceSecretRevisions(modelName, secretName, "value4", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "current_revision", "4"),
+					resource.TestCheckResourceAttr(resourceName, "revisions.#", "2"),
+				),
+			},
+			{
+				// Changing only the threshold prunes the older revisions.
+				Config: testAccResourceSecretRevisions(modelName, secretName, "value4", 4),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "current_revision", "4"),
+					resource.TestCheckResourceAttr(resourceName, "revisions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revisions.0.revision", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceSecret_BinaryValues(t *testing.T) {
+	skipTestIfSecretsNotSupported(t)
+
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	secretName := "tf-test-secret"
+	resourceName := "juju_secret." + secretName
+
+	keystore := filepath.Join(t.TempDir(), "keystore.jks")
+	writeKeystore := func(content []byte) {
+		if err := os.WriteFile(keystore, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	initialContent := []byte{0xfe, 0xed, 0xfe, 0xed, 0x00, 0x01}
+	updatedContent := []byte{0xfe, 0xed, 0xfe, 0xed, 0x00, 0x02}
+	writeKeystore(initialContent)
+	checksum := func(content []byte) string {
+		sum := sha256.Sum256(content)
+		return hex.EncodeToString(sum[:])
+	}
+	certificate := base64.StdEncoding.EncodeToString([]byte{0x30, 0x82, 0x01, 0x0a})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecretBinaryValues(modelName, secretName, certificate, keystore),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value.password", "changeit"),
+					resource.TestCheckResourceAttr(resourceName, "value_base64.certificate", certificate),
+					resource.TestCheckResourceAttr(resourceName, "value_files.keystore", keystore),
+					resource.TestCheckResourceAttr(resourceName, "value_files_sha256.keystore", checksum(initialContent)),
+					resource.TestCheckResourceAttr(resourceName, "current_revision", "1"),
+				),
+			},
+			{
+				PreConfig: func() { writeKeystore(updatedContent) },
+				Config:    testAccResourceSecretBinaryValues(modelName, secretName, certificate, keystore),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value.password", "changeit"),
+					resource.TestCheckResourceAttr(resourceName, "value_files_sha256.keystore", checksum(updatedContent)),
+					resource.TestCheckResourceAttr(resourceName, "current_revision", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceSecret_CreateUpdateWriteOnlyValue(t *testing.T) {
 	skipTestIfSecretsNotSupported(t)
 
@@ -295,14 +411,14 @@ func TestAcc_ResourceSecret_WriteOnlyConfigValidation(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				// Both value and value_wo set -> ExactlyOneOf violation.
+				// Both value and value_wo set -> Conflicting violation.
 				Config:      testAccResourceSecretBothValues(modelName, secretName, secretValue),
-				ExpectError: regexp.MustCompile(`(?s)Exactly one of these attributes must be configured: \[value,value_wo\]`),
+				ExpectError: regexp.MustCompile(`(?s)These attributes cannot be configured together: \[value,value_wo\]`),
 			},
 			{
-				// Neither value nor value_wo set -> ExactlyOneOf violation.
+				// No content set -> AtLeastOneOf violation.
 				Config:      testAccResourceSecretNoValues(modelName, secretName),
-				ExpectError: regexp.MustCompile(`(?s)Exactly one of these attributes must be configured: \[value,value_wo\]`),
+				ExpectError: regexp.MustCompile(`(?s)At least one of these attributes must be configured:\s+\[value,value_wo,value_base64,value_files\]`),
 			},
 			{
 				// value_wo without value_wo_version -> RequiredTogether violation.
@@ -341,6 +457,64 @@ resource "juju_secret" "{{.SecretName}}" {
 		})
 }
 
+func testAccResourceSecretRevisions(modelName, secretName, value string, pruneOlderThan int) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceSecretRevisions",
+		`
+resource "juju_model" "{{.ModelName}}" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_secret" "{{.SecretName}}" {
+  model_uuid = juju_model.{{.ModelName}}.uuid
+  name       = "{{.SecretName}}"
+  value = {
+    key = "{{.Value}}"
+  }
+  # Juju would remove unused revisions on its own, keep them to test
+  # explicit pruning.
+  auto_prune = false
+  {{- if gt .PruneOlderThan 0 }}
+  prune_revisions_older_than = {{.PruneOlderThan}}
+  {{- end }}
+}
+`, internaltesting.TemplateData{
+			"ModelName":      modelName,
+			"SecretName":     secretName,
+			"Value":          value,
+			"PruneOlderThan": pruneOlderThan,
+		})
+}
+
+func testAccResourceSecretBinaryValues(modelName, secretName, certificate, keystore string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceSecretBinaryValues",
+		`
+resource "juju_model" "{{.ModelName}}" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_secret" "{{.SecretName}}" {
+  model_uuid = juju_model.{{.ModelName}}.uuid
+  name       = "{{.SecretName}}"
+  value = {
+    password = "changeit"
+  }
+  value_base64 = {
+    certificate = "{{.Certificate}}"
+  }
+  value_files = {
+    keystore = "{{.Keystore}}"
+  }
+}
+`, internaltesting.TemplateData{
+			"ModelName":   modelName,
+			"SecretName":  secretName,
+			"Certificate": certificate,
+			"Keystore":    keystore,
+		})
+}
+
 func testAccResourceSecretWithoutName(modelName string, secretValue map[string]string, secretInfo string) string {
 	return internaltesting.GetStringFromTemplateWithData(
 		"testAccResourceSecret",
@@ -7,15 +7,22 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/juju/rpc/params"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -25,6 +32,7 @@ var _ resource.Resource = &userResource{}
 var _ resource.ResourceWithConfigure = &userResource{}
 var _ resource.ResourceWithImportState = &userResource{}
 var _ resource.ResourceWithConfigValidators = &userResource{}
+var _ resource.ResourceWithIdentity = &userResource{}
 
 // NewUserResource returns a user resource.
 func NewUserResource() resource.Resource {
@@ -45,10 +53,23 @@ type userResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	DisplayName types.String `tfsdk:"display_name"`
 	Password    types.String `tfsdk:"password"`
+	// PasswordWO is the write-only equivalent of Password. It is never
+	// persisted to state.
+	PasswordWO types.String `tfsdk:"password_wo"`
+	// PasswordWOVersion triggers an update of the write-only PasswordWO.
+	PasswordWOVersion  types.Int64  `tfsdk:"password_wo_version"`
+	Disabled           types.Bool   `tfsdk:"disabled"`
+	RegistrationString types.String `tfsdk:"registration_string"`
+	LastConnection     types.String `tfsdk:"last_connection"`
+	DateCreated        types.String `tfsdk:"date_created"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
 
+type userResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 // Metadata implements resource.ResourceWithConfigure interface.
 func (r *userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
@@ -59,6 +80,14 @@ func (r *userResource) ConfigValidators(ctx context.Context) []resource.ConfigVa
 	return []resource.ConfigValidator{
 		// There is no JAAS object that replaces the user resource since JAAS users come from an external identity provider.
 		NewAvoidJAASValidator(r.client, ""),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("password"),
+			path.MatchRoot("password_wo"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("password_wo"),
+			path.MatchRoot("password_wo_version"),
+		),
 	}
 }
 
@@ -88,9 +117,54 @@ func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "The password to be assigned to the user.",
-				Required:    true,
-				Sensitive:   true,
+				Description: "The password to be assigned to the user. Conflicts with password_wo. When" +
+					" neither password nor password_wo is set, the user is created without a password" +
+					" and registration_string is set.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "The write-only password to be assigned to the user. It is never persisted" +
+					" to Terraform state. Requires password_wo_version to be set; bump password_wo_version" +
+					" to apply changes to this value. Requires Terraform >= 1.11.",
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "The version of password_wo. Increment this value to trigger an update of" +
+					" the write-only password.",
+				Optional: true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Whether the user is disabled. A disabled user cannot log in.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"registration_string": schema.StringAttribute{
+				Description: "The token to pass to `juju register` for the user to set its password and" +
+					" log in. Only set when the user is created without a password.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_connection": schema.StringAttribute{
+				Description: "The time, in RFC3339 format, the user last connected to the controller." +
+					" Null if the user never connected.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_created": schema.StringAttribute{
+				Description: "The time, in RFC3339 format, the user was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -144,10 +218,16 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	_, err := r.client.Users.CreateUser(ctx, juju.CreateUserInput{
+	password, diags := r.resolvePassword(ctx, data, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := r.client.Users.CreateUser(ctx, juju.CreateUserInput{
 		Name:        data.Name.ValueString(),
 		DisplayName: data.DisplayName.ValueString(),
-		Password:    data.Password.ValueString(),
+		Password:    password,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user resource, got error: %s", err))
@@ -155,8 +235,44 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 	r.trace(fmt.Sprintf("created user resource %q", data.Name))
 
-	// Save data into Terraform state
+	if createResp.RegistrationString != "" {
+		data.RegistrationString = types.StringValue(createResp.RegistrationString)
+	} else {
+		data.RegistrationString = types.StringNull()
+	}
+
+	// Save the user as soon as it exists, so that a failure below does
+	// not leave a user unknown to Terraform. The timestamps are read next.
 	data.ID = types.StringValue(newIDFromUserName(data.Name.ValueString()))
+	data.DateCreated = types.StringNull()
+	data.LastConnection = types.StringNull()
+	disabled := data.Disabled
+	data.Disabled = types.BoolValue(false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := userResourceIdentityModel{ID: data.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if disabled.ValueBool() {
+		if err := r.client.Users.DisableUser(ctx, data.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable user, got error: %s", err))
+			return
+		}
+	}
+	data.Disabled = disabled
+
+	readResp, err := r.client.Users.ReadUser(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user resource after create, got error: %s", err))
+		return
+	}
+	setUserTimestamps(&data, readResp.UserInfo)
+
+	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -203,10 +319,14 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Save updated data into Terraform state
 	plan := userResourceModel{
-		Name:     types.StringValue(response.UserInfo.Username),
-		Password: data.Password,
-		ID:       types.StringValue(newIDFromUserName(response.UserInfo.Username)),
+		Name:               types.StringValue(response.UserInfo.Username),
+		Password:           data.Password,
+		PasswordWOVersion:  data.PasswordWOVersion,
+		Disabled:           types.BoolValue(response.UserInfo.Disabled),
+		RegistrationString: data.RegistrationString,
+		ID:                 types.StringValue(newIDFromUserName(response.UserInfo.Username)),
 	}
+	setUserTimestamps(&plan, response.UserInfo)
 	// Display name is optional, therefore if it doesn't exist in the plan,
 	// do not add an empty string as they are not the same thing.
 	// Conversely, if the returned user info contains an empty string for
@@ -217,6 +337,9 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		plan.DisplayName = types.StringNull()
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	identity := userResourceIdentityModel{ID: plan.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 // Update is called to update the state of the resource. Config, planned
@@ -241,36 +364,78 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	if data.Name.Equal(state.Name) || data.DisplayName.Equal(state.DisplayName) {
+	if !data.DisplayName.Equal(state.DisplayName) {
 		// This does violates terraform's declarative model. There is a
-		// todo to make both values ForceNew in the future.
-		resp.Diagnostics.AddWarning("Not Supported", "Unable to update name %q or display name %q")
+		// todo to make display name ForceNew in the future.
+		resp.Diagnostics.AddWarning("Not Supported", fmt.Sprintf("Unable to update display name %q", data.DisplayName.ValueString()))
 	}
-	if data.Password.Equal(state.Password) {
-		r.info(fmt.Sprintf("Password not different, no updates for user %q made", data.Name.ValueString()))
-		return
+	// The write-only password_wo cannot be compared, a change of
+	// password_wo_version triggers sending it again.
+	passwordChanged := !data.Password.Equal(state.Password)
+	if !data.PasswordWOVersion.IsNull() {
+		passwordChanged = !data.PasswordWOVersion.Equal(state.PasswordWOVersion)
 	}
-	// Update user can only change the user's password. It is not currently
-	// possible to change the display name via terraform after the user is
-	// created. Nor is it possible to change an existing username.
-	if err := r.client.Users.UpdateUser(ctx, juju.UpdateUserInput{
-		Name:     data.Name.ValueString(),
-		Password: data.Password.ValueString(),
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user resource, got error: %s", err))
-		return
+	if passwordChanged {
+		password, diags := r.resolvePassword(ctx, data, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Update user can only change the user's password. It is not currently
+		// possible to change the display name via terraform after the user is
+		// created. Nor is it possible to change an existing username.
+		if password != "" {
+			if err := r.client.Users.UpdateUser(ctx, juju.UpdateUserInput{
+				Name:     data.Name.ValueString(),
+				Password: password,
+			}); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user resource, got error: %s", err))
+				return
+			}
+		} else {
+			r.info(fmt.Sprintf("Password removed from the configuration, password of user %q left unchanged", data.Name.ValueString()))
+		}
+	}
+
+	if !data.Disabled.Equal(state.Disabled) {
+		var err error
+		if data.Disabled.ValueBool() {
+			err = r.client.Users.DisableUser(ctx, data.Name.ValueString())
+		} else {
+			err = r.client.Users.EnableUser(ctx, data.Name.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update disabled status of user, got error: %s", err))
+			return
+		}
 	}
 	r.trace(fmt.Sprintf("updated user resource %q", data.Name))
 
+	readResp, err := r.client.Users.ReadUser(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user resource after update, got error: %s", err))
+		return
+	}
+
 	// Save updated data into Terraform state, save a new copy for
 	// update functionality.
 	plan := userResourceModel{
-		Name:        types.StringValue(data.Name.ValueString()),
-		DisplayName: data.DisplayName,
-		Password:    types.StringValue(data.Password.ValueString()),
-		ID:          types.StringValue(newIDFromUserName(data.Name.ValueString())),
+		Name:               types.StringValue(data.Name.ValueString()),
+		DisplayName:        data.DisplayName,
+		Password:           data.Password,
+		PasswordWOVersion:  data.PasswordWOVersion,
+		Disabled:           data.Disabled,
+		RegistrationString: state.RegistrationString,
+		ID:                 types.StringValue(newIDFromUserName(data.Name.ValueString())),
 	}
+	setUserTimestamps(&plan, readResp.UserInfo)
+	// The planned last_connection is the prior state, a connection made
+	// during the apply is picked up by the next refresh.
+	plan.LastConnection = state.LastConnection
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	identity := userResourceIdentityModel{ID: plan.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
@@ -312,8 +477,42 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	r.trace(fmt.Sprintf("deleted user resource %q", data.Name.ValueString()))
 }
 
+// resolvePassword returns the password to send to Juju. It is read from
+// the write-only password_wo attribute (via config) when
+// password_wo_version is set, and otherwise from the password attribute.
+func (r *userResource) resolvePassword(ctx context.Context, plan userResourceModel, config tfsdk.Config) (string, diag.Diagnostics) {
+	if plan.PasswordWOVersion.IsNull() {
+		return plan.Password.ValueString(), nil
+	}
+	var password types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &password)
+	return password.ValueString(), diags
+}
+
+// setUserTimestamps sets the creation and last connection times of the
+// user from the Juju user info.
+func setUserTimestamps(data *userResourceModel, info params.UserInfo) {
+	data.DateCreated = types.StringValue(info.DateCreated.Format(time.RFC3339))
+	if info.LastConnection != nil {
+		data.LastConnection = types.StringValue(info.LastConnection.Format(time.RFC3339))
+	} else {
+		data.LastConnection = types.StringNull()
+	}
+}
+
+// IdentitySchema implements [resource.ResourceWithIdentity].
+func (r *userResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *userResource) info(msg string, additionalFields ...map[string]interface{}) {
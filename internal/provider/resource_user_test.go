@@ -4,12 +4,20 @@
 package provider
 
 import (
+	"encoding/asn1"
+	"encoding/base64"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/juju/juju/api/jujuclient"
 )
 
 func TestAcc_ResourceUser(t *testing.T) {
@@ -98,3 +106,116 @@ func TestAcc_ResourceUser_ErrorWhenUsedWithJAAS(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_ResourceUser_RegistrationAndDisabled(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+
+	resourceName := "juju_user.user"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserWithoutPassword(userName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", userName),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttrWith(resourceName, "registration_string", checkRegistrationString(userName)),
+					resource.TestCheckResourceAttrSet(resourceName, "date_created"),
+					resource.TestCheckNoResourceAttr(resourceName, "last_connection"),
+				),
+			},
+			{
+				Config: testAccResourceUserWithoutPassword(userName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("last_connection"), knownvalue.Null()),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "registration_string"),
+				),
+			},
+			{
+				Config: testAccResourceUserWithoutPassword(userName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+// checkRegistrationString checks that a registration string decodes to the
+// given user and names the controller, so `juju register` does not prompt
+// for it.
+func checkRegistrationString(userName string) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		data, err := base64.URLEncoding.DecodeString(value)
+		if err != nil {
+			return fmt.Errorf("decoding registration string: %w", err)
+		}
+		var info jujuclient.RegistrationInfo
+		if _, err := asn1.Unmarshal(data, &info); err != nil {
+			return fmt.Errorf("unmarshalling registration string: %w", err)
+		}
+		if info.User != userName {
+			return fmt.Errorf("expected registration string for user %q, got %q", userName, info.User)
+		}
+		if info.ControllerName == "" {
+			return fmt.Errorf("expected registration string to name the controller")
+		}
+		return nil
+	}
+}
+
+func TestAcc_ResourceUser_PasswordWriteOnly(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+
+	resourceName := "juju_user.user"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserPasswordWO(userName, acctest.RandomWithPrefix("tf-test-user"), 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckNoResourceAttr(resourceName, "password"),
+					resource.TestCheckNoResourceAttr(resourceName, "registration_string"),
+				),
+			},
+			{
+				Config: testAccResourceUserPasswordWO(userName, acctest.RandomWithPrefix("tf-test-user"), 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceUserWithoutPassword(userName string, disabled bool) string {
+	return fmt.Sprintf(`
+resource "juju_user" "user" {
+  name     = %q
+  disabled = %t
+}`, userName, disabled)
+}
+
+func testAccResourceUserPasswordWO(userName, userPassword string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "juju_user" "user" {
+  name                = %q
+  password_wo         = %q
+  password_wo_version = %d
+}`, userName, userPassword, passwordVersion)
+}